@@ -0,0 +1,77 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// alias is a table for sampling indices in [0, n) with probabilities proportional
+// to the given weights in O(1) time, using Walker's alias method.
+type alias struct {
+	prob  []float64
+	alias []int
+}
+
+// newAlias returns false if weights are empty, contain negative, NaN or infinite values, or sum to zero.
+func newAlias(weights []float64) (alias, bool) {
+	// "Darts, Dice, and Coins: Sampling from a Discrete Distribution" by Keith Schwarz, https://www.keithschwarz.com/darts-dice-coins/
+	n := len(weights)
+	var sum float64
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return alias{}, false
+		}
+		sum += w
+	}
+	if !(sum > 0) || math.IsInf(sum, 1) {
+		return alias{}, false
+	}
+
+	a := alias{prob: make([]float64, n), alias: make([]int, n)}
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		a.prob[i] = w / sum * float64(n)
+		if a.prob[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		a.alias[s] = l
+		a.prob[l] -= 1 - a.prob[s]
+		if a.prob[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// leftovers are due to rounding errors, and have probability of 1
+	for _, i := range large {
+		a.prob[i] = 1
+	}
+	for _, i := range small {
+		a.prob[i] = 1
+	}
+	return a, true
+}
+
+// next uses goroutine-local pseudo-random data source when r is nil.
+func (a *alias) next(r *Rand) int {
+	var i int
+	var u float64
+	if r == nil {
+		i, u = Intn(len(a.prob)), Float64()
+	} else {
+		i, u = r.Intn(len(a.prob)), r.Float64()
+	}
+	if u < a.prob[i] {
+		return i
+	}
+	return a.alias[i]
+}
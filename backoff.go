@@ -0,0 +1,95 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "time"
+
+// BackoffJitter is a jitter strategy for [Backoff].
+//
+// See "Exponential Backoff And Jitter" by Marc Brooker,
+// https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+type BackoffJitter int
+
+const (
+	// NoJitter produces delays of min(limit, base * 2^attempt).
+	NoJitter BackoffJitter = iota
+	// FullJitter produces delays uniformly distributed in [0, min(limit, base * 2^attempt)).
+	FullJitter
+	// EqualJitter produces delays uniformly distributed in [d/2, d), where d = min(limit, base * 2^attempt).
+	EqualJitter
+	// DecorrelatedJitter produces delays uniformly distributed in [base, 3 * previous delay),
+	// capped at limit.
+	DecorrelatedJitter
+)
+
+// A Backoff generates successive delays for retrying an operation with exponential backoff.
+type Backoff struct {
+	r       *Rand
+	base    time.Duration
+	limit   time.Duration
+	jitter  BackoffJitter
+	attempt int
+	prev    time.Duration
+}
+
+// NewBackoff returns a Backoff that generates delays growing exponentially from base
+// and capped at limit, using the jitter strategy and r as the source of randomness.
+// NewBackoff panics if base <= 0, limit < base or jitter is not a known strategy.
+func NewBackoff(r *Rand, base time.Duration, limit time.Duration, jitter BackoffJitter) *Backoff {
+	if base <= 0 || limit < base || jitter < NoJitter || jitter > DecorrelatedJitter {
+		panic("invalid argument to NewBackoff")
+	}
+	return &Backoff{
+		r:      r,
+		base:   base,
+		limit:  limit,
+		jitter: jitter,
+		prev:   base,
+	}
+}
+
+// Next returns the delay before the next retry.
+func (b *Backoff) Next() time.Duration {
+	var d time.Duration
+	switch b.jitter {
+	case NoJitter:
+		d = b.exp()
+	case FullJitter:
+		d = b.r.DurationBetween(0, b.exp())
+	case EqualJitter:
+		e := b.exp()
+		d = b.r.DurationBetween(e/2, e)
+	case DecorrelatedJitter:
+		hi := b.limit
+		if b.prev <= hi/3 {
+			hi = b.prev * 3
+		}
+		d = b.r.DurationBetween(b.base, hi)
+	}
+	b.attempt++
+	b.prev = d
+	return d
+}
+
+// Attempt returns the number of delays generated since the Backoff was created or reset.
+func (b *Backoff) Attempt() int {
+	return b.attempt
+}
+
+// Reset restarts the delay sequence, typically after a successful operation.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+	b.prev = b.base
+}
+
+// exp returns min(limit, base * 2^attempt) without overflow.
+func (b *Backoff) exp() time.Duration {
+	if b.attempt >= 63 || b.base > b.limit>>b.attempt {
+		return b.limit
+	}
+	return b.base << b.attempt
+}
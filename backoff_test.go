@@ -0,0 +1,52 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+	"time"
+)
+
+func TestBackoff_Next(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		base := time.Duration(rapid.Int64Range(1, int64(time.Hour)).Draw(t, "base").(int64))
+		limit := time.Duration(rapid.Int64Min(int64(base)).Draw(t, "limit").(int64))
+		jitter := rand.BackoffJitter(rapid.IntRange(int(rand.NoJitter), int(rand.DecorrelatedJitter)).Draw(t, "jitter").(int))
+		b := rand.NewBackoff(rand.New(s), base, limit, jitter)
+		for i := 0; i < 100; i++ {
+			d := b.Next()
+			if d < 0 || d > limit {
+				t.Fatalf("got delay %v outside of [0, %v] at attempt %v", d, limit, i)
+			}
+			if jitter == rand.NoJitter && base <= limit>>i && d != base<<i {
+				t.Fatalf("got delay %v instead of %v at attempt %v", d, base<<i, i)
+			}
+			if jitter == rand.DecorrelatedJitter && d < base {
+				t.Fatalf("got delay %v below base %v at attempt %v", d, base, i)
+			}
+		}
+	})
+}
+
+func TestBackoff_Reset(t *testing.T) {
+	b1 := rand.NewBackoff(rand.New(1), time.Millisecond, time.Minute, rand.FullJitter)
+	b2 := rand.NewBackoff(rand.New(1), time.Millisecond, time.Minute, rand.FullJitter)
+	for i := 0; i < 10; i++ {
+		b1.Next()
+		b2.Next()
+	}
+	b1.Reset()
+	if b1.Attempt() != 0 {
+		t.Fatalf("got attempt %v after reset", b1.Attempt())
+	}
+	if d1, d2 := b1.Next(), b2.Next(); d1 > time.Millisecond || d2 <= time.Millisecond {
+		t.Fatalf("got delays %v and %v, reset had no effect", d1, d2)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.23
+
+package rand
+
+import "iter"
+
+// Batches returns an infinite sequence of mini-batches of indices in [0, n), for training loops.
+// Every epoch is a new random permutation of [0, n) split into batches of batchSize indices;
+// the last batch of an epoch is shorter when batchSize does not divide n.
+// A batch is only valid until the next one is produced: all batches share a single backing array,
+// so iterating allocates nothing after the start. Batches panics if n < 1 or batchSize < 1.
+//
+// When r is nil, Batches uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func Batches(r *Rand, n int, batchSize int) iter.Seq[[]int] {
+	if n < 1 || batchSize < 1 {
+		panic("invalid argument to Batches")
+	}
+	return func(yield func([]int) bool) {
+		p := make([]int, n)
+		for i := range p {
+			p[i] = i
+		}
+		swap := func(i, j int) { p[i], p[j] = p[j], p[i] }
+		for {
+			// shuffling the previous permutation produces a uniformly random one
+			if r == nil {
+				Shuffle(n, swap)
+			} else {
+				r.shuffleBatched(p)
+			}
+			for i := 0; i < n; i += batchSize {
+				if !yield(p[i:min(i+batchSize, n):min(i+batchSize, n)]) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.23
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestBatches(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, small).Draw(t, "n").(int)
+		size := rapid.IntRange(1, n+1).Draw(t, "size").(int)
+		epochs := rapid.IntRange(1, 3).Draw(t, "epochs").(int)
+		perBatch := (n + size - 1) / size
+		var epoch [][]int
+		count := 0
+		for b := range rand.Batches(rand.New(s), n, size) {
+			epoch = append(epoch, append([]int(nil), b...))
+			if len(b) > size || len(b) < size && len(epoch) != perBatch {
+				t.Fatalf("got batch of size %v, batch size %v", len(b), size)
+			}
+			if len(epoch) == perBatch {
+				checkPartition(t, n, epoch...)
+				epoch = nil
+				if count++; count == epochs {
+					break
+				}
+			}
+		}
+	})
+}
+
+func TestBatches_Allocs(t *testing.T) {
+	seq := rand.Batches(rand.New(1), 100, 7)
+	allocs := testing.AllocsPerRun(10, func() {
+		i := 0
+		for range seq {
+			if i++; i == 1000 {
+				break
+			}
+		}
+	})
+	if allocs > 3 {
+		t.Fatalf("got %v allocations for 70 epochs", allocs)
+	}
+}
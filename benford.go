@@ -0,0 +1,35 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// BenfordDigit returns, as an int, a leading digit in the closed interval [1, 9] distributed
+// according to Benford's law: the probability of d is log10(1 + 1/d).
+func (r *Rand) BenfordDigit() int {
+	d := int(math.Pow(10, r.Float64()))
+	if d > 9 {
+		d = 9 // rounding
+	}
+	return d
+}
+
+// Benford returns, as a float64, a pseudo-random number in the half-open interval [10^minExp, 10^maxExp)
+// with a log-uniform distribution. Because the interval spans a whole number of decades,
+// the leading digits of the results follow Benford's law, as they do in many real-world datasets,
+// e.g. financial transaction amounts. Benford panics if minExp >= maxExp.
+func (r *Rand) Benford(minExp int, maxExp int) float64 {
+	if minExp >= maxExp {
+		panic("invalid argument to Benford")
+	}
+	hi := math.Pow(10, float64(maxExp))
+	v := math.Pow(10, float64(minExp)+r.Float64()*float64(maxExp-minExp))
+	if v >= hi {
+		v = math.Nextafter(hi, 0) // rounding
+	}
+	return v
+}
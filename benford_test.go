@@ -0,0 +1,59 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func checkBenford(t *testing.T, digit func() int) {
+	t.Helper()
+	const n = 100000
+	observed := make([]int, 9)
+	expected := make([]float64, 9)
+	for i := 0; i < n; i++ {
+		observed[digit()-1]++
+	}
+	for d := 1; d <= 9; d++ {
+		expected[d-1] = n * math.Log10(1+1/float64(d))
+	}
+	if _, p := randtest.ChiSquared(observed, expected); p < 1e-4 {
+		t.Errorf("got p-value %v for %v", p, observed)
+	}
+}
+
+func TestRand_BenfordDigit(t *testing.T) {
+	r := rand.New(1)
+	checkBenford(t, r.BenfordDigit)
+}
+
+func TestRand_Benford(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lo := rapid.IntRange(-300, 300).Draw(t, "lo").(int)
+		hi := rapid.IntRange(lo+1, 308).Draw(t, "hi").(int)
+		r := rand.New(s)
+		for i := 0; i < 16; i++ {
+			if v := r.Benford(lo, hi); v < math.Pow(10, float64(lo)) || v >= math.Pow(10, float64(hi)) {
+				t.Fatalf("got %v outside of [1e%v, 1e%v)", v, lo, hi)
+			}
+		}
+	})
+
+	r := rand.New(1)
+	checkBenford(t, func() int {
+		v := r.Benford(0, 6)
+		for v >= 10 {
+			v /= 10
+		}
+		return int(v)
+	})
+}
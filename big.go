@@ -0,0 +1,102 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// BigIntn returns, as a *big.Int, a uniformly distributed pseudo-random number
+// in the half-open interval [0, n). It panics if n <= 0.
+// Unlike crypto/rand.Int, BigIntn generates the number directly into the words of the result.
+func (r *Rand) BigIntn(n *big.Int) *big.Int {
+	if n.Sign() <= 0 {
+		panic("invalid argument to BigIntn")
+	}
+	// number of bits in n-1, the largest value to generate
+	bitLen := n.BitLen()
+	if n.TrailingZeroBits() == uint(bitLen-1) {
+		bitLen-- // n is a power of 2
+	}
+	if bitLen == 0 {
+		return new(big.Int)
+	}
+	words := make([]big.Word, (bitLen+bits.UintSize-1)/bits.UintSize)
+	v := new(big.Int)
+	for {
+		// rejection sampling over the smallest power of 2 covering n
+		// accepts each candidate with probability above 1/2
+		r.fillBigBits(words, bitLen)
+		v.SetBits(words)
+		if v.Cmp(n) < 0 {
+			return v
+		}
+	}
+}
+
+// fillBigBits fills words with bitLen pseudo-random low-order bits; the other bits are zero.
+func (r *Rand) fillBigBits(words []big.Word, bitLen int) {
+	for i := range words {
+		words[i] = big.Word(r.next64())
+	}
+	words[len(words)-1] &= big.Word(1)<<((bitLen-1)%bits.UintSize+1) - 1
+}
+
+// BigFloat returns, as a *big.Float with precision prec, a uniformly distributed pseudo-random number
+// in the half-open interval [0.0, 1.0). All prec bits of the mantissa are random, so small values
+// have the same relative resolution as large ones: the result is a real number chosen uniformly
+// from [0, 1) and rounded down to prec bits. BigFloat panics if prec == 0 or prec > big.MaxPrec.
+func (r *Rand) BigFloat(prec uint) *big.Float {
+	if prec == 0 || prec > big.MaxPrec {
+		panic("invalid argument to BigFloat")
+	}
+	// the position of the leading 1 bit follows a geometric distribution
+	exp := 0
+	for {
+		w := r.next64()
+		if w != 0 {
+			exp -= bits.LeadingZeros64(w) + 1
+			break
+		}
+		exp -= 64
+	}
+	m := new(big.Int)
+	if prec > 1 {
+		words := make([]big.Word, (int(prec)-1+bits.UintSize-1)/bits.UintSize)
+		r.fillBigBits(words, int(prec)-1)
+		m.SetBits(words)
+	}
+	m.SetBit(m, int(prec)-1, 1)
+	f := new(big.Float).SetPrec(prec).SetInt(m)
+	return f.SetMantExp(f, exp-int(prec)+1)
+}
+
+// Prime returns, as a *big.Int, a number of bit length bitLen that is prime with high probability,
+// like crypto/rand.Prime: the top two bits are set, so that the product of two such primes has
+// exactly 2*bitLen bits. Unlike crypto/rand.Prime, Prime is deterministic for a given state of r,
+// which makes it suitable for reproducible test key material, but never for real keys.
+// Prime panics if bitLen < 2.
+func (r *Rand) Prime(bitLen int) *big.Int {
+	if bitLen < 2 {
+		panic("invalid argument to Prime")
+	}
+	words := make([]big.Word, (bitLen+bits.UintSize-1)/bits.UintSize)
+	p := new(big.Int)
+	for {
+		r.fillBigBits(words, bitLen)
+		top, second := bitLen-1, bitLen-2
+		words[top/bits.UintSize] |= 1 << (top % bits.UintSize)
+		words[second/bits.UintSize] |= 1 << (second % bits.UintSize)
+		words[0] |= 1
+		p.SetBits(words)
+		// ProbablyPrime(20) is what crypto/rand.Prime uses; it is deterministic
+		if p.ProbablyPrime(20) {
+			return p
+		}
+	}
+}
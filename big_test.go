@@ -0,0 +1,109 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math/big"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_BigIntn(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		b := rapid.SliceOfN(rapid.Byte(), 1, 64).Draw(t, "n").([]byte)
+		n := new(big.Int).SetBytes(b)
+		if n.Sign() == 0 {
+			n.SetInt64(1)
+		}
+		r := rand.New(s)
+		v := r.BigIntn(n)
+		if v.Sign() < 0 || v.Cmp(n) >= 0 {
+			t.Fatalf("got %v outside of [0, %v)", v, n)
+		}
+	})
+}
+
+func TestRand_BigIntn_Small(t *testing.T) {
+	r := rand.New(1)
+	var counts [3]int
+	for i := 0; i < small*3; i++ {
+		counts[r.BigIntn(big.NewInt(3)).Int64()]++
+	}
+	for i, c := range counts {
+		if c < small/2 {
+			t.Fatalf("got %v draws of %v out of %v", c, i, small*3)
+		}
+	}
+	if v := r.BigIntn(big.NewInt(1)); v.Sign() != 0 {
+		t.Fatalf("got %v instead of 0", v)
+	}
+}
+
+func BenchmarkRand_BigIntn(b *testing.B) {
+	r := rand.New(1)
+	n := new(big.Int).Lsh(big.NewInt(1), 2048)
+	n.Sub(n, big.NewInt(small))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r.BigIntn(n)
+	}
+}
+
+func TestRand_BigFloat(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		prec := rapid.UintRange(1, 1024).Draw(t, "prec").(uint)
+		r := rand.New(s)
+		f := r.BigFloat(prec)
+		if f.Prec() != prec {
+			t.Fatalf("got precision %v instead of %v", f.Prec(), prec)
+		}
+		if f.Sign() < 0 || f.Cmp(big.NewFloat(1)) >= 0 {
+			t.Fatalf("got %v outside of [0, 1)", f)
+		}
+		if f.Acc() != big.Exact {
+			t.Fatalf("got inexact %v", f)
+		}
+	})
+}
+
+func TestRand_BigFloat_Mean(t *testing.T) {
+	r := rand.New(1)
+	sum := new(big.Float)
+	for i := 0; i < small*10; i++ {
+		sum.Add(sum, r.BigFloat(100))
+	}
+	mean, _ := sum.Quo(sum, big.NewFloat(small*10)).Float64()
+	if mean < 0.45 || mean > 0.55 {
+		t.Fatalf("got mean %v instead of 0.5", mean)
+	}
+}
+
+func TestRand_Prime(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		bits := rapid.IntRange(2, 256).Draw(t, "bits").(int)
+		r1 := rand.New(s)
+		r2 := rand.New(s)
+		p := r1.Prime(bits)
+		if p.BitLen() != bits || p.Bit(bits-2) != 1 || !p.ProbablyPrime(20) {
+			t.Fatalf("got %v for %v bits", p, bits)
+		}
+		if q := r2.Prime(bits); q.Cmp(p) != 0 {
+			t.Fatalf("got %v and %v for the same seed", p, q)
+		}
+	})
+}
+
+func BenchmarkRand_Prime(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		r.Prime(512)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// Bit returns a pseudo-random bit (0 or 1) as an uint64.
+// It is equivalent to Bits(1).
+func (r *Rand) Bit() uint64 {
+	return r.Bits(1)
+}
+
+// Bits returns n pseudo-random low-order bits as an uint64; the other bits are zero.
+// Bits are drawn from an internal buffer, so that drawing many small bit counts
+// does not consume a full 64-bit value each time. Bits panics if n < 0 or n > 64.
+func (r *Rand) Bits(n int) uint64 {
+	if n < 0 || n > 64 {
+		panic("invalid argument to Bits")
+	}
+	if n <= r.bitPos {
+		v := r.bitVal & (1<<n - 1)
+		r.bitVal >>= n
+		r.bitPos -= n
+		return v
+	}
+	// use up the buffered bits, and take the rest from a fresh value
+	w := r.next64()
+	need := n - r.bitPos
+	v := (r.bitVal | w<<r.bitPos) & (1<<n - 1)
+	r.bitVal, r.bitPos = w>>need, 64-need
+	return v
+}
+
+// Sign returns -1 or +1 with equal probability.
+func (r *Rand) Sign() int {
+	return int(r.Bits(1))*2 - 1
+}
+
+// SignFloat64 returns -1.0 or +1.0 with equal probability.
+func (r *Rand) SignFloat64() float64 {
+	return float64(r.Sign())
+}
+
+// Byte returns a pseudo-random byte. Like [Rand.Bits], it consumes only 8 bits of a 64-bit value.
+func (r *Rand) Byte() byte {
+	return byte(r.Bits(8))
+}
+
+// Uint16 returns a pseudo-random 16-bit value as an uint16. Like [Rand.Bits], it consumes only 16 bits of a 64-bit value.
+func (r *Rand) Uint16() uint16 {
+	return uint16(r.Bits(16))
+}
+
+// Int8 returns a pseudo-random 8-bit value as an int8, which can be negative.
+// Like [Rand.Bits], it consumes only 8 bits of a 64-bit value.
+func (r *Rand) Int8() int8 {
+	return int8(r.Bits(8))
+}
+
+// Int16 returns a pseudo-random 16-bit value as an int16, which can be negative.
+// Like [Rand.Bits], it consumes only 16 bits of a 64-bit value.
+func (r *Rand) Int16() int16 {
+	return int16(r.Bits(16))
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Bits(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		ns := rapid.SliceOf(rapid.IntRange(0, 64)).Draw(t, "ns").([]int)
+		r := rand.New(s)
+		var got []uint64
+		for _, n := range ns {
+			v := r.Bits(n)
+			if n < 64 && v>>n != 0 {
+				t.Fatalf("got %#x with more than %v bits", v, n)
+			}
+			got = append(got, v)
+		}
+		// the concatenation of all bits drawn must match the raw 64-bit stream
+		r.Seed(s)
+		var word uint64
+		left := 0
+		for i, n := range ns {
+			var v uint64
+			for k := 0; k < n; k++ {
+				if left == 0 {
+					word, left = r.Uint64(), 64
+				}
+				v |= (word & 1) << k
+				word >>= 1
+				left--
+			}
+			if v != got[i] {
+				t.Fatalf("got %#x instead of %#x at %v", got[i], v, i)
+			}
+		}
+	})
+}
+
+func TestRand_Bits_Marshal(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, 64).Draw(t, "n").(int)
+		r1 := rand.New(s)
+		r1.Bits(n)
+		data, _ := r1.MarshalBinary()
+		var r2 rand.Rand
+		if err := r2.UnmarshalBinary(data); err != nil {
+			t.Fatalf("got unexpected unmarshal error: %v", err)
+		}
+		for i := 0; i < 4; i++ {
+			if u, v := r1.Bits(17), r2.Bits(17); u != v {
+				t.Fatalf("got %v instead of %v after marshal/unmarshal", v, u)
+			}
+		}
+	})
+}
+
+func BenchmarkRand_Bit(b *testing.B) {
+	var s uint64
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		s = r.Bit()
+	}
+	sinkUint64 = s
+}
+
+func TestRand_Sign(t *testing.T) {
+	r := rand.New(1)
+	counts := map[int]int{}
+	for i := 0; i < small; i++ {
+		s := r.Sign()
+		if s != -1 && s != 1 {
+			t.Fatalf("got %v instead of -1 or +1", s)
+		}
+		counts[s]++
+		if f := r.SignFloat64(); f != -1 && f != 1 {
+			t.Fatalf("got %v instead of -1.0 or +1.0", f)
+		}
+	}
+	if counts[-1] < small/3 || counts[1] < small/3 {
+		t.Fatalf("got unbalanced signs: %v", counts)
+	}
+}
+
+func TestRand_SmallInts(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		r1 := rand.New(s)
+		r2 := rand.New(s)
+		got := []uint64{uint64(r1.Byte()), uint64(r1.Uint16()), uint64(uint8(r1.Int8())), uint64(uint16(r1.Int16()))}
+		want := []uint64{r2.Bits(8), r2.Bits(16), r2.Bits(8), r2.Bits(16)}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("got %#x instead of %#x at %v", got[i], want[i], i)
+			}
+		}
+		if r1.State() != r2.State() {
+			t.Fatalf("small integers consumed a different amount of randomness than Bits")
+		}
+	})
+}
+
+func BenchmarkRand_Byte(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		sinkInt = int(r.Byte())
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestBounded_Next(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.Uint64Range(1, math.MaxUint64).Draw(t, "n").(uint64)
+		r := rand.New(s)
+		b := rand.NewBounded(n)
+		for i := 0; i < 16; i++ {
+			if v := b.Next(r); v >= n {
+				t.Fatalf("got %v outside of [0, %v)", v, n)
+			}
+		}
+	})
+}
+
+func TestBounded_Zero(t *testing.T) {
+	r := rand.New(1)
+	b := rand.NewBounded(0)
+	if v := b.Next(r); v != 0 {
+		t.Fatalf("got %v instead of 0", v)
+	}
+}
+
+func BenchmarkBounded_Next(b *testing.B) {
+	var s uint64
+	r := rand.New(1)
+	bd := rand.NewBounded(small)
+	for i := 0; i < b.N; i++ {
+		s = bd.Next(r)
+	}
+	sinkUint64 = s
+}
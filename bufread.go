@@ -0,0 +1,73 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+const bufferedSize = 256
+
+// BufferedReader reads pseudo-random bytes from a Rand through an internal
+// 256-byte buffer refilled in bulk, which makes many small reads several times faster.
+// It produces the same bytes a series of Read calls on the underlying Rand would,
+// as long as the Rand is not used directly while the BufferedReader holds unread bytes.
+// A BufferedReader is not safe for concurrent use.
+type BufferedReader struct {
+	r   *Rand
+	buf [bufferedSize]byte
+	pos int
+	end int
+}
+
+// NewBufferedReader returns a BufferedReader reading from r.
+func NewBufferedReader(r *Rand) *BufferedReader {
+	if r == nil {
+		panic("invalid argument to NewBufferedReader")
+	}
+	return &BufferedReader{r: r}
+}
+
+// Buffered returns the number of bytes already drawn from the underlying Rand but not yet read.
+func (b *BufferedReader) Buffered() int {
+	return b.end - b.pos
+}
+
+// Read generates len(p) pseudo-random bytes and writes them into p. It always returns len(p) and a nil error.
+func (b *BufferedReader) Read(p []byte) (n int, err error) {
+	n = copy(p, b.buf[b.pos:b.end])
+	b.pos += n
+	if n == len(p) {
+		return
+	}
+	if len(p)-n >= bufferedSize {
+		_, _ = b.r.Read(p[n:])
+		return len(p), nil
+	}
+	b.fill()
+	m := copy(p[n:], b.buf[:])
+	b.pos = m
+	return n + m, nil
+}
+
+// ReadByte returns a single pseudo-random byte. It always returns a nil error.
+func (b *BufferedReader) ReadByte() (byte, error) {
+	if b.pos == b.end {
+		b.fill()
+	}
+	c := b.buf[b.pos]
+	b.pos++
+	return c, nil
+}
+
+// Zero wipes the buffered bytes and the state of the underlying Rand, see [Rand.Zero].
+func (b *BufferedReader) Zero() {
+	b.buf = [bufferedSize]byte{}
+	b.pos, b.end = 0, 0
+	b.r.Zero()
+}
+
+func (b *BufferedReader) fill() {
+	_, _ = b.r.Read(b.buf[:])
+	b.pos, b.end = 0, bufferedSize
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"bytes"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestBufferedReader_SameStream(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		sizes := rapid.SliceOf(rapid.IntRange(0, 600)).Draw(t, "sizes").([]int)
+		r1 := rand.New(s)
+		b := rand.NewBufferedReader(rand.New(s))
+		for _, n := range sizes {
+			p1, p2 := make([]byte, n), make([]byte, n)
+			_, _ = r1.Read(p1)
+			if n == 1 {
+				p2[0], _ = b.ReadByte()
+			} else {
+				_, _ = b.Read(p2)
+			}
+			if !bytes.Equal(p1, p2) {
+				t.Fatalf("got %x instead of %x", p2, p1)
+			}
+		}
+	})
+}
+
+func BenchmarkRand_Read4(b *testing.B) {
+	r := rand.New(1)
+	var p [4]byte
+	b.SetBytes(int64(len(p)))
+	for i := 0; i < b.N; i++ {
+		_, _ = r.Read(p[:])
+	}
+}
+
+func BenchmarkBufferedReader_Read4(b *testing.B) {
+	br := rand.NewBufferedReader(rand.New(1))
+	var p [4]byte
+	b.SetBytes(int64(len(p)))
+	for i := 0; i < b.N; i++ {
+		_, _ = br.Read(p[:])
+	}
+}
+
+func BenchmarkBufferedReader_ReadByte(b *testing.B) {
+	br := rand.NewBufferedReader(rand.New(1))
+	b.SetBytes(1)
+	for i := 0; i < b.N; i++ {
+		c, _ := br.ReadByte()
+		sinkInt = int(c)
+	}
+}
+
+func TestBufferedReader_Zero(t *testing.T) {
+	r := rand.New(1)
+	b := rand.NewBufferedReader(r)
+	_, _ = b.ReadByte()
+	b.Zero()
+	if b.Buffered() != 0 || r.State() != [rand.StateWords]uint64{} {
+		t.Fatalf("got %v buffered bytes and state %v after Zero", b.Buffered(), r.State())
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Float32s fills dst with uniformly distributed pseudo-random numbers in the half-open interval [0.0, 1.0).
+// The values are the same as the ones produced by len(dst) calls to [Rand.Float32].
+func (r *Rand) Float32s(dst []float32) {
+	i := 0
+	if r.pos >= 4 && len(dst) > 0 {
+		dst[0] = float32(r.next32()&int24Mask) * f24Mul
+		i = 1
+	}
+	if len(dst)-i >= 2 {
+		// same as pairs of next32 calls: high half first, then low half
+		r.pos = 0
+		s := r.sfc64 // local copy lets the compiler keep the state in registers
+		for ; i+2 <= len(dst); i += 2 {
+			v := s.next64()
+			dst[i] = float32(v>>32&int24Mask) * f24Mul
+			dst[i+1] = float32(v&int24Mask) * f24Mul
+		}
+		r.sfc64 = s
+	}
+	if i < len(dst) {
+		dst[i] = float32(r.next32()&int24Mask) * f24Mul
+	}
+}
+
+// Float64s fills dst with uniformly distributed pseudo-random numbers in the half-open interval [0.0, 1.0).
+// The values are the same as the ones produced by len(dst) calls to [Rand.Float64].
+func (r *Rand) Float64s(dst []float64) {
+	s := r.sfc64 // local copy lets the compiler keep the state in registers
+	for i := range dst {
+		dst[i] = float64(s.next64()&int53Mask) * f53Mul
+	}
+	r.sfc64 = s
+}
+
+// Uint32s fills dst with uniformly distributed pseudo-random 32-bit values.
+// The values are the same as the ones produced by len(dst) calls to [Rand.Uint32].
+func (r *Rand) Uint32s(dst []uint32) {
+	i := 0
+	if r.pos >= 4 && len(dst) > 0 {
+		dst[0] = uint32(r.next32())
+		i = 1
+	}
+	if len(dst)-i >= 2 {
+		// see Rand.Float32s
+		r.pos = 0
+		s := r.sfc64
+		for ; i+2 <= len(dst); i += 2 {
+			v := s.next64()
+			dst[i] = uint32(v >> 32)
+			dst[i+1] = uint32(v)
+		}
+		r.sfc64 = s
+	}
+	if i < len(dst) {
+		dst[i] = uint32(r.next32())
+	}
+}
+
+// Uint64s fills dst with uniformly distributed pseudo-random 64-bit values.
+// The values are the same as the ones produced by len(dst) calls to [Rand.Uint64].
+func (r *Rand) Uint64s(dst []uint64) {
+	s := r.sfc64
+	for i := range dst {
+		dst[i] = s.next64()
+	}
+	r.sfc64 = s
+}
+
+// Int63s fills dst with uniformly distributed non-negative pseudo-random 63-bit integers.
+// The values are the same as the ones produced by len(dst) calls to [Rand.Int63].
+func (r *Rand) Int63s(dst []int64) {
+	s := r.sfc64
+	for i := range dst {
+		dst[i] = int64(s.next64() & int63Mask)
+	}
+	r.sfc64 = s
+}
+
+// Uint32ns fills dst with uniformly distributed pseudo-random numbers in [0, n).
+// The values are the same as the ones produced by len(dst) calls to [Rand.Uint32n].
+func (r *Rand) Uint32ns(dst []uint32, n uint32) {
+	s := r.sfc64
+	for i := range dst {
+		res, _ := bits.Mul64(uint64(n), s.next64())
+		dst[i] = uint32(res)
+	}
+	r.sfc64 = s
+}
+
+// Uint64ns fills dst with uniformly distributed pseudo-random numbers in [0, n).
+// The values are the same as the ones produced by len(dst) calls to [Rand.Uint64n].
+func (r *Rand) Uint64ns(dst []uint64, n uint64) {
+	s := r.sfc64
+	if n <= math.MaxUint32 {
+		// bound is known to be small, so the whole loop takes the single-multiplication path
+		for i := range dst {
+			res, _ := bits.Mul64(n, s.next64())
+			dst[i] = res
+		}
+	} else {
+		for i := range dst {
+			res, frac := bits.Mul64(n, s.next64())
+			hi, _ := bits.Mul64(n, s.next64())
+			_, carry := bits.Add64(frac, hi, 0)
+			dst[i] = res + carry
+		}
+	}
+	r.sfc64 = s
+}
+
+// NormFloat64s fills dst with normally distributed pseudo-random numbers
+// with standard normal distribution (mean = 0, stddev = 1).
+// The values are the same as the ones produced by len(dst) calls to [Rand.NormFloat64].
+func (r *Rand) NormFloat64s(dst []float64) {
+	s := r.sfc64
+	for i := 0; i < len(dst); {
+		v := s.next64()
+		j := int64(v) >> 11
+		k := v & 0xFF
+		x := float64(j) * wn[k]
+		if absInt64(j) >= kn[k] {
+			r.sfc64 = s
+			var ok bool
+			x, ok = r.normSlow(j, k, x)
+			s = r.sfc64
+			if !ok {
+				continue
+			}
+		}
+		dst[i] = x
+		i++
+	}
+	r.sfc64 = s
+}
+
+// ExpFloat64s fills dst with exponentially distributed pseudo-random numbers
+// with rate parameter (lambda) 1 and mean 1.
+// The values are the same as the ones produced by len(dst) calls to [Rand.ExpFloat64].
+func (r *Rand) ExpFloat64s(dst []float64) {
+	s := r.sfc64
+	for i := 0; i < len(dst); {
+		v := s.next64()
+		j := v >> 11
+		k := v & 0xFF
+		x := float64(j) * we[k]
+		if j >= ke[k] {
+			r.sfc64 = s
+			var ok bool
+			x, ok = r.expSlow(k, x)
+			s = r.sfc64
+			if !ok {
+				continue
+			}
+		}
+		dst[i] = x
+		i++
+	}
+	r.sfc64 = s
+}
+
+// addNoiseChunk is the number of noise values AddNoise generates at a time.
+const addNoiseChunk = 128
+
+// AddNoise adds normally distributed pseudo-random noise with mean 0 and standard deviation sigma
+// to every element of dst. The noise values are the same as the ones produced by len(dst) calls
+// to [Rand.NormFloat64], multiplied by sigma. AddNoise panics if sigma is negative, infinite or NaN.
+//
+// When r is nil, AddNoise uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func AddNoise(r *Rand, dst []float64, sigma float64) {
+	if !(sigma >= 0) || math.IsInf(sigma, 1) {
+		panic("invalid argument to AddNoise")
+	}
+	if r == nil {
+		// a private generator seeded from the goroutine-local source keeps the nil case on the bulk path
+		var l Rand
+		l.init1(Uint64())
+		r = &l
+	}
+	var buf [addNoiseChunk]float64
+	for len(dst) > 0 {
+		n := len(buf)
+		if len(dst) < n {
+			n = len(dst)
+		}
+		r.NormFloat64s(buf[:n])
+		for i, x := range buf[:n] {
+			dst[i] += sigma * x
+		}
+		dst = dst[n:]
+	}
+}
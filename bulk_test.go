@@ -0,0 +1,198 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Float32s(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		skip := rapid.IntRange(0, 9).Draw(t, "skip").(int)
+		r := rand.New(s)
+		_, _ = r.Read(make([]byte, skip))
+		dst := make([]float32, n+1)
+		r.Float32s(dst[:n])
+		dst[n] = r.Float32()
+		r.Seed(s)
+		_, _ = r.Read(make([]byte, skip))
+		for i, f := range dst {
+			if g := r.Float32(); f != g {
+				t.Fatalf("got %v instead of %v at %v", f, g, i)
+			}
+		}
+	})
+}
+
+func TestRand_Float64s(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		dst := make([]float64, n+1)
+		r.Float64s(dst[:n])
+		dst[n] = r.Float64()
+		r.Seed(s)
+		for i, f := range dst {
+			if g := r.Float64(); f != g {
+				t.Fatalf("got %v instead of %v at %v", f, g, i)
+			}
+		}
+	})
+}
+
+func TestRand_Uint32s(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		skip := rapid.IntRange(0, 9).Draw(t, "skip").(int)
+		r := rand.New(s)
+		_, _ = r.Read(make([]byte, skip))
+		dst := make([]uint32, n+1)
+		r.Uint32s(dst[:n])
+		dst[n] = r.Uint32()
+		r.Seed(s)
+		_, _ = r.Read(make([]byte, skip))
+		for i, u := range dst {
+			if v := r.Uint32(); u != v {
+				t.Fatalf("got %v instead of %v at %v", u, v, i)
+			}
+		}
+	})
+}
+
+func TestRand_Uint64s(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		dst := make([]uint64, n)
+		r.Uint64s(dst)
+		dst2 := make([]int64, n)
+		r.Int63s(dst2)
+		r.Seed(s)
+		for i, u := range dst {
+			if v := r.Uint64(); u != v {
+				t.Fatalf("got %v instead of %v at %v", u, v, i)
+			}
+		}
+		for i, u := range dst2 {
+			if v := r.Int63(); u != v {
+				t.Fatalf("got %v instead of %v at %v", u, v, i)
+			}
+		}
+	})
+}
+
+func TestRand_Uint32ns(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		bound := rapid.Uint32().Draw(t, "bound").(uint32)
+		r := rand.New(s)
+		dst := make([]uint32, n)
+		r.Uint32ns(dst, bound)
+		r.Seed(s)
+		for i, u := range dst {
+			if v := r.Uint32n(bound); u != v {
+				t.Fatalf("got %v instead of %v at %v", u, v, i)
+			}
+		}
+	})
+}
+
+func TestRand_Uint64ns(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		bound := rapid.Uint64().Draw(t, "bound").(uint64)
+		r := rand.New(s)
+		dst := make([]uint64, n)
+		r.Uint64ns(dst, bound)
+		r.Seed(s)
+		for i, u := range dst {
+			if v := r.Uint64n(bound); u != v {
+				t.Fatalf("got %v instead of %v at %v", u, v, i)
+			}
+		}
+	})
+}
+
+func TestRand_NormFloat64s(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		dst := make([]float64, n+1)
+		r.NormFloat64s(dst[:n])
+		dst[n] = r.NormFloat64()
+		r.Seed(s)
+		for i, f := range dst {
+			if g := r.NormFloat64(); f != g {
+				t.Fatalf("got %v instead of %v at %v", f, g, i)
+			}
+		}
+	})
+}
+
+func TestRand_ExpFloat64s(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		dst := make([]float64, n+1)
+		r.ExpFloat64s(dst[:n])
+		dst[n] = r.ExpFloat64()
+		r.Seed(s)
+		for i, f := range dst {
+			if g := r.ExpFloat64(); f != g {
+				t.Fatalf("got %v instead of %v at %v", f, g, i)
+			}
+		}
+	})
+}
+
+func TestAddNoise(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		sigma := rapid.Float64Range(0, 100).Draw(t, "sigma").(float64)
+		dst := make([]float64, n+1)
+		for i := range dst {
+			dst[i] = float64(i)
+		}
+		r := rand.New(s)
+		rand.AddNoise(r, dst[:n], sigma)
+		dst[n] += sigma * r.NormFloat64()
+		r.Seed(s)
+		for i, f := range dst {
+			if g := float64(i) + sigma*r.NormFloat64(); f != g {
+				t.Fatalf("got %v instead of %v at %v", f, g, i)
+			}
+		}
+	})
+}
+
+func TestAddNoise_Nil(t *testing.T) {
+	const n = 10000
+	dst := make([]float64, n)
+	rand.AddNoise(nil, dst, 2)
+	var sum, sumSq float64
+	for _, f := range dst {
+		sum += f
+		sumSq += f * f
+	}
+	mean := sum / n
+	if std := math.Sqrt(sumSq/n - mean*mean); mean < -0.1 || mean > 0.1 || std < 1.9 || std > 2.1 {
+		t.Fatalf("got mean %v and stddev %v instead of 0 and 2", mean, std)
+	}
+}
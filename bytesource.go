@@ -0,0 +1,120 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// A ByteSource draws values from a finite byte slice, e.g. the one provided to a fuzz target
+// by testing.F, so that coverage-guided fuzzing can explore the values drawn. It implements [Drawer]
+// and [Source64]. Once the data is exhausted, ByteSource keeps drawing values as if it were padded
+// with zeroes, which makes the drawn values minimal, and reports exhaustion with [ByteSource.Exhausted].
+type ByteSource struct {
+	data      []byte
+	exhausted bool
+}
+
+// NewByteSource returns a ByteSource drawing values from data.
+func NewByteSource(data []byte) *ByteSource {
+	return &ByteSource{data: data}
+}
+
+// Exhausted reports whether any of the values drawn required more data than was available.
+func (s *ByteSource) Exhausted() bool {
+	return s.exhausted
+}
+
+// Remaining returns the number of bytes not yet consumed.
+func (s *ByteSource) Remaining() int {
+	return len(s.data)
+}
+
+func (s *ByteSource) take(n int) uint64 {
+	var buf [8]byte
+	if copy(buf[:n], s.data) < n {
+		s.exhausted = true
+	}
+	if len(s.data) < n {
+		s.data = nil
+	} else {
+		s.data = s.data[n:]
+	}
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate parameter 1, consuming 8 bytes.
+func (s *ByteSource) ExpFloat64() float64 {
+	return -math.Log1p(-s.Float64())
+}
+
+// Float64 returns a float64 in the half-open interval [0.0, 1.0), consuming 8 bytes.
+func (s *ByteSource) Float64() float64 {
+	return float64(s.take(8)&int53Mask) * f53Mul
+}
+
+// Int returns a non-negative int, consuming 8 bytes.
+func (s *ByteSource) Int() int {
+	return int(s.take(8) & intMask)
+}
+
+// Int63 returns a non-negative 63-bit integer as an int64, consuming 8 bytes.
+func (s *ByteSource) Int63() int64 {
+	return int64(s.take(8) & int63Mask)
+}
+
+// Int63n returns, as an int64, a non-negative number in the half-open interval [0, n),
+// consuming 4 or 8 bytes depending on n. It panics if n <= 0.
+func (s *ByteSource) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	return int64(s.Uint64n(uint64(n)))
+}
+
+// Intn returns, as an int, a non-negative number in the half-open interval [0, n),
+// consuming 4 or 8 bytes depending on n. It panics if n <= 0.
+func (s *ByteSource) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	return int(s.Uint64n(uint64(n)))
+}
+
+// NormFloat64 returns a standard normally distributed float64, consuming 16 bytes.
+func (s *ByteSource) NormFloat64() float64 {
+	// Box–Muller transform; zero data produces zero
+	u, v := s.Float64(), s.Float64()
+	return math.Sqrt(-2*math.Log1p(-u)) * math.Cos(2*math.Pi*v)
+}
+
+// Uint32 returns a 32-bit value as an uint32, consuming 4 bytes.
+func (s *ByteSource) Uint32() uint32 {
+	return uint32(s.take(4))
+}
+
+// Uint32n returns, as an uint32, a number in [0, n), consuming 4 bytes. Uint32n(0) returns 0.
+func (s *ByteSource) Uint32n(n uint32) uint32 {
+	return uint32(uint64(n) * s.take(4) >> 32)
+}
+
+// Uint64 returns a 64-bit value as an uint64, consuming 8 bytes.
+func (s *ByteSource) Uint64() uint64 {
+	return s.take(8)
+}
+
+// Uint64n returns, as an uint64, a number in [0, n), consuming 4 bytes if n fits in 32 bits
+// and 8 bytes otherwise. Uint64n(0) returns 0.
+func (s *ByteSource) Uint64n(n uint64) uint64 {
+	if n <= math.MaxUint32 {
+		return uint64(s.Uint32n(uint32(n)))
+	}
+	hi, _ := bits.Mul64(n, s.take(8))
+	return hi
+}
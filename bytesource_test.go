@@ -0,0 +1,70 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"testing"
+)
+
+var (
+	_ rand.Drawer   = (*rand.ByteSource)(nil)
+	_ rand.Source64 = (*rand.ByteSource)(nil)
+)
+
+func FuzzByteSource(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		s := rand.NewByteSource(data)
+		for !s.Exhausted() {
+			if v := s.Intn(10); v < 0 || v >= 10 {
+				t.Fatalf("got Intn(10) = %v", v)
+			}
+			if v := s.Uint64n(1 << 40); v >= 1<<40 {
+				t.Fatalf("got Uint64n(1<<40) = %v", v)
+			}
+			if v := s.Float64(); v < 0 || v >= 1 {
+				t.Fatalf("got Float64() = %v", v)
+			}
+			if v := s.ExpFloat64(); v < 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+				t.Fatalf("got ExpFloat64() = %v", v)
+			}
+			if v := s.NormFloat64(); math.IsInf(v, 0) || math.IsNaN(v) {
+				t.Fatalf("got NormFloat64() = %v", v)
+			}
+		}
+		if s.Remaining() != 0 {
+			t.Fatalf("got %v bytes remaining after exhaustion", s.Remaining())
+		}
+	})
+}
+
+func TestByteSource(t *testing.T) {
+	s := rand.NewByteSource([]byte{1, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0xff})
+	if v := s.Uint32(); v != 1 {
+		t.Fatalf("got %v instead of 1", v)
+	}
+	if v := s.Uint64(); v != 2 {
+		t.Fatalf("got %v instead of 2", v)
+	}
+	if s.Exhausted() || s.Remaining() != 1 {
+		t.Fatalf("got exhausted = %v, remaining = %v", s.Exhausted(), s.Remaining())
+	}
+	if v := s.Uint64(); v != 0xff {
+		t.Fatalf("got %v instead of 0xff", v)
+	}
+	if !s.Exhausted() || s.Remaining() != 0 {
+		t.Fatalf("got exhausted = %v, remaining = %v", s.Exhausted(), s.Remaining())
+	}
+	if v := s.Intn(10); v != 0 {
+		t.Fatalf("got %v instead of 0 after exhaustion", v)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Rand generates pseudo-random values for shell scripts:
+//
+//	rand int 1 6        # integer in the closed interval [1, 6]
+//	rand float          # float in [0, 1)
+//	rand uuid           # version 4 UUID
+//	rand token 32       # base32 token of the given length (26 by default)
+//	rand shuffle < f    # lines of stdin in random order
+//	rand bytes 1024     # raw bytes
+//
+// With -seed, the output is reproducible. Use -n to print several values, one per line.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"github.com/gozelle/rand"
+	"io"
+	"log"
+	"os"
+	"strconv"
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "usage: rand [flags] int LO HI | float | uuid | token [LEN] | shuffle | bytes N\n")
+	flag.PrintDefaults()
+}
+
+func uuid(r *rand.Rand) string {
+	var u [16]byte
+	_, _ = r.Read(u[:])
+	u[6] = u[6]&0x0f | 0x40 // version 4
+	u[8] = u[8]&0x3f | 0x80 // variant 10
+	h := hex.EncodeToString(u[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+func intArgs(args []string, n int) ([]int64, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("expected %v arguments, got %v", n, len(args))
+	}
+	v := make([]int64, n)
+	for i, a := range args {
+		var err error
+		if v[i], err = strconv.ParseInt(a, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func shuffle(w io.Writer, r *rand.Rand, src io.Reader) error {
+	var lines []string
+	s := bufio.NewScanner(src)
+	s.Buffer(nil, 1<<30)
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	r.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+	for _, l := range lines {
+		if _, err := fmt.Fprintln(w, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func run(w io.Writer, stdin io.Reader, r *rand.Rand, n int, cmd string, args []string) error {
+	var gen func() string
+	switch cmd {
+	case "int":
+		v, err := intArgs(args, 2)
+		if err != nil {
+			return err
+		}
+		lo, hi := v[0], v[1]
+		if lo > hi {
+			return fmt.Errorf("empty interval [%v, %v]", lo, hi)
+		}
+		gen = func() string {
+			span := uint64(hi-lo) + 1
+			if span == 0 {
+				return strconv.FormatInt(int64(r.Uint64()), 10) // whole int64 range
+			}
+			return strconv.FormatInt(lo+int64(r.Uint64n(span)), 10)
+		}
+	case "float":
+		gen = func() string { return strconv.FormatFloat(r.Float64(), 'g', -1, 64) }
+	case "uuid":
+		gen = func() string { return uuid(r) }
+	case "token":
+		length := int64(26)
+		if len(args) > 0 {
+			v, err := intArgs(args, 1)
+			if err != nil {
+				return err
+			}
+			if length = v[0]; length < 0 {
+				return fmt.Errorf("invalid token length %v", length)
+			}
+		}
+		gen = func() string { return r.TextN(int(length)) }
+	case "shuffle":
+		if len(args) != 0 {
+			return fmt.Errorf("shuffle takes no arguments")
+		}
+		return shuffle(w, r, stdin)
+	case "bytes":
+		v, err := intArgs(args, 1)
+		if err != nil {
+			return err
+		}
+		if v[0] < 0 {
+			return fmt.Errorf("invalid byte count %v", v[0])
+		}
+		_, err = r.WriteRandom(w, v[0])
+		return err
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+	for i := 0; i < n; i++ {
+		if _, err := fmt.Fprintln(w, gen()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	var (
+		seed = flag.Uint64("seed", 0, "generator seed (random if not set)")
+		n    = flag.Int("n", 1, "number of values to print")
+	)
+	flag.Usage = usage
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("rand: ")
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	r := rand.New()
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			r = rand.New(*seed)
+		}
+	})
+	w := bufio.NewWriter(os.Stdout)
+	err := run(w, os.Stdin, r, *n, flag.Arg(0), flag.Args()[1:])
+	if ferr := w.Flush(); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"github.com/gozelle/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	const seed = 1
+	tests := []struct {
+		name  string
+		n     int
+		cmd   string
+		args  []string
+		stdin string
+		check func(t *testing.T, out string)
+	}{
+		{"int", 5, "int", []string{"1", "6"}, "", func(t *testing.T, out string) {
+			r := rand.New(seed)
+			var want strings.Builder
+			for i := 0; i < 5; i++ {
+				want.WriteString(strconv.FormatInt(1+int64(r.Uint64n(6)), 10) + "\n")
+			}
+			if out != want.String() {
+				t.Fatalf("got %q instead of %q", out, want.String())
+			}
+		}},
+		{"int full range", 3, "int", []string{"-9223372036854775808", "9223372036854775807"}, "", func(t *testing.T, out string) {
+			r := rand.New(seed)
+			for _, line := range strings.Fields(out) {
+				if want := strconv.FormatInt(int64(r.Uint64()), 10); line != want {
+					t.Fatalf("got %v instead of %v", line, want)
+				}
+			}
+		}},
+		{"float", 3, "float", nil, "", func(t *testing.T, out string) {
+			for _, line := range strings.Fields(out) {
+				if f, err := strconv.ParseFloat(line, 64); err != nil || f < 0 || f >= 1 {
+					t.Fatalf("got %q outside of [0, 1)", line)
+				}
+			}
+		}},
+		{"uuid", 2, "uuid", nil, "", func(t *testing.T, out string) {
+			re := regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}\n){2}$`)
+			if !re.MatchString(out) {
+				t.Fatalf("got %q instead of 2 version 4 UUIDs", out)
+			}
+		}},
+		{"token", 2, "token", nil, "", func(t *testing.T, out string) {
+			if want := rand.New(seed).TextN(26); strings.Fields(out)[0] != want || len(strings.Fields(out)) != 2 {
+				t.Fatalf("got %q, want 2 tokens starting with %q", out, want)
+			}
+		}},
+		{"token length", 1, "token", []string{"7"}, "", func(t *testing.T, out string) {
+			if want := rand.New(seed).TextN(7) + "\n"; out != want {
+				t.Fatalf("got %q instead of %q", out, want)
+			}
+		}},
+		{"bytes", 1, "bytes", []string{"13"}, "", func(t *testing.T, out string) {
+			var want bytes.Buffer
+			_, _ = rand.New(seed).WriteRandom(&want, 13)
+			if out != want.String() {
+				t.Fatalf("got %x instead of %x", out, want.String())
+			}
+		}},
+		{"shuffle", 1, "shuffle", nil, "a\nb\nc\nd\n", func(t *testing.T, out string) {
+			lines := strings.Fields(out)
+			sort.Strings(lines)
+			if strings.Join(lines, ",") != "a,b,c,d" {
+				t.Fatalf("got %q instead of a permutation of the input lines", out)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := run(&out, strings.NewReader(tt.stdin), rand.New(seed), tt.n, tt.cmd, tt.args); err != nil {
+				t.Fatalf("got unexpected error: %v", err)
+			}
+			tt.check(t, out.String())
+		})
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := []struct {
+		cmd  string
+		args []string
+	}{
+		{"foo", nil},
+		{"int", []string{"1"}},
+		{"int", []string{"1", "x"}},
+		{"int", []string{"6", "1"}},
+		{"token", []string{"-1"}},
+		{"token", []string{"1", "2"}},
+		{"bytes", nil},
+		{"bytes", []string{"-1"}},
+		{"shuffle", []string{"x"}},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := run(&out, strings.NewReader(""), rand.New(1), 1, tt.cmd, tt.args); err == nil {
+			t.Errorf("%v %q: got no error", tt.cmd, tt.args)
+		}
+	}
+}
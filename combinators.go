@@ -0,0 +1,85 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+const combinatorMaxAttempts = 1000
+
+// Map returns a generator of values produced by g and transformed by f.
+func Map[T any, U any](g func(*Rand) T, f func(T) U) func(*Rand) U {
+	return func(r *Rand) U {
+		return f(g(r))
+	}
+}
+
+// Filter returns a generator of values produced by g that satisfy pred. The generator
+// panics if it fails to produce a satisfying value after many attempts, so pred should
+// not reject most of the values.
+func Filter[T any](g func(*Rand) T, pred func(T) bool) func(*Rand) T {
+	return func(r *Rand) T {
+		for i := 0; i < combinatorMaxAttempts; i++ {
+			if v := g(r); pred(v) {
+				return v
+			}
+		}
+		panic("rand: Filter failed to generate a value satisfying the predicate")
+	}
+}
+
+// OneOf returns a generator of values produced by one of gens, chosen with equal probabilities.
+// It panics if gens are empty.
+func OneOf[T any](gens ...func(*Rand) T) func(*Rand) T {
+	if len(gens) == 0 {
+		panic("invalid argument to OneOf")
+	}
+	gens = append([]func(*Rand) T(nil), gens...)
+	return func(r *Rand) T {
+		return gens[r.Intn(len(gens))](r)
+	}
+}
+
+// SliceOf returns a generator of slices of values produced by g, with length uniformly
+// distributed in the closed interval [minLen, maxLen]. It panics if minLen < 0 or minLen > maxLen.
+func SliceOf[T any](g func(*Rand) T, minLen int, maxLen int) func(*Rand) []T {
+	if minLen < 0 || minLen > maxLen {
+		panic("invalid argument to SliceOf")
+	}
+	return func(r *Rand) []T {
+		s := make([]T, minLen+r.Intn(maxLen-minLen+1))
+		for i := range s {
+			s[i] = g(r)
+		}
+		return s
+	}
+}
+
+// MapOf returns a generator of maps with keys produced by key and values produced by val,
+// with size uniformly distributed in the closed interval [minLen, maxLen]. Duplicate keys are
+// regenerated; the generator panics if it fails to produce enough distinct keys after many attempts.
+// MapOf panics if minLen < 0 or minLen > maxLen.
+func MapOf[K comparable, V any](key func(*Rand) K, val func(*Rand) V, minLen int, maxLen int) func(*Rand) map[K]V {
+	if minLen < 0 || minLen > maxLen {
+		panic("invalid argument to MapOf")
+	}
+	return func(r *Rand) map[K]V {
+		n := minLen + r.Intn(maxLen-minLen+1)
+		m := make(map[K]V, n)
+		for misses := 0; len(m) < n; {
+			k := key(r)
+			if _, ok := m[k]; ok {
+				misses++
+				if misses >= combinatorMaxAttempts {
+					panic("rand: MapOf failed to generate enough distinct keys")
+				}
+				continue
+			}
+			m[k] = val(r)
+		}
+		return m
+	}
+}
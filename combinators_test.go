@@ -0,0 +1,54 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"strconv"
+	"testing"
+)
+
+func TestCombinators(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		minLen := rapid.IntRange(0, 10).Draw(t, "minLen").(int)
+		maxLen := rapid.IntRange(minLen, 20).Draw(t, "maxLen").(int)
+		r := rand.New(s)
+
+		digit := func(r *rand.Rand) int { return r.Intn(10) }
+		even := rand.Filter(digit, func(v int) bool { return v%2 == 0 })
+		small := rand.OneOf(even, func(r *rand.Rand) int { return -1 })
+		str := rand.Map(small, strconv.Itoa)
+		slices := rand.SliceOf(str, minLen, maxLen)
+		maps := rand.MapOf(rand.Map(even, strconv.Itoa), slices, 0, 5)
+
+		ss := slices(r)
+		if len(ss) < minLen || len(ss) > maxLen {
+			t.Fatalf("got slice of length %v outside of [%v, %v]", len(ss), minLen, maxLen)
+		}
+		for _, v := range ss {
+			if n, err := strconv.Atoi(v); err != nil || (n != -1 && (n%2 != 0 || n < 0 || n >= 10)) {
+				t.Fatalf("got unexpected element %q", v)
+			}
+		}
+		if m := maps(r); len(m) > 5 {
+			t.Fatalf("got map of size %v", len(m))
+		}
+	})
+}
+
+func TestMapOf_NotEnoughKeys(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("MapOf did not panic")
+		}
+	}()
+	rand.MapOf(func(r *rand.Rand) bool { return r.Bit() == 1 }, func(*rand.Rand) int { return 0 }, 3, 3)(rand.New(1))
+}
@@ -6,6 +6,11 @@
 
 package rand_test
 
+import (
+	"github.com/gozelle/rand/randtest"
+	"testing"
+)
+
 const (
 	tiny  = 52
 	small = 1000
@@ -20,3 +25,25 @@ var (
 	sinkFloat64 float64
 	sinkFloat32 float32
 )
+
+// checkUniformOutcomes checks that gen produces want distinct outcomes with equal probabilities.
+func checkUniformOutcomes(t *testing.T, want int, gen func() string) {
+	t.Helper()
+	const n = 10000
+	counts := map[string]int{}
+	for i := 0; i < n; i++ {
+		counts[gen()]++
+	}
+	if len(counts) != want {
+		t.Fatalf("got %v distinct outcomes instead of %v", len(counts), want)
+	}
+	observed := make([]int, 0, want)
+	expected := make([]float64, 0, want)
+	for _, c := range counts {
+		observed = append(observed, c)
+		expected = append(expected, n/float64(want))
+	}
+	if chi2, p := randtest.ChiSquared(observed, expected); p < 1e-4 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "context"
+
+type contextKey struct{}
+
+// WithRand returns a copy of ctx carrying r, for passing a request-scoped generator
+// through code that does not take it as an argument. Like any [Rand], r must not be used
+// concurrently by the goroutines that share ctx.
+func WithRand(ctx context.Context, r *Rand) context.Context {
+	return context.WithValue(ctx, contextKey{}, r)
+}
+
+// FromContext returns the generator stored in ctx by [WithRand]. If ctx carries no generator,
+// FromContext returns a new non-deterministically seeded one, so that the result is always usable;
+// use [RandFromContext] to tell the two cases apart.
+func FromContext(ctx context.Context) *Rand {
+	if r, ok := RandFromContext(ctx); ok {
+		return r
+	}
+	return New()
+}
+
+// RandFromContext returns the generator stored in ctx by [WithRand], and whether there was one.
+func RandFromContext(ctx context.Context) (*Rand, bool) {
+	r, ok := ctx.Value(contextKey{}).(*Rand)
+	return r, ok && r != nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"context"
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	r := rand.New(1)
+	ctx := rand.WithRand(context.Background(), r)
+	if got := rand.FromContext(ctx); got != r {
+		t.Fatalf("got %p instead of %p", got, r)
+	}
+	if got, ok := rand.RandFromContext(context.Background()); got != nil || ok {
+		t.Fatalf("got %p, %v from an empty context", got, ok)
+	}
+	if got := rand.FromContext(context.Background()); got == nil || got == r {
+		t.Fatalf("got %p as a fallback generator", got)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// CorrelatedNormals returns a pair of standard normally distributed float64s with correlation rho.
+// It panics if rho is outside of [-1, 1].
+func (r *Rand) CorrelatedNormals(rho float64) (float64, float64) {
+	if !(rho >= -1 && rho <= 1) {
+		panic("invalid argument to CorrelatedNormals")
+	}
+	x, y := r.NormFloat64(), r.NormFloat64()
+	return x, rho*x + math.Sqrt(1-rho*rho)*y
+}
+
+// CorrelatedUniforms returns a pair of float64s uniformly distributed in the closed interval [0.0, 1.0],
+// joined by the Gaussian copula with correlation rho of the underlying normals. Applying quantile functions
+// (inverse CDFs) to the results produces a pair of correlated values with arbitrary marginal distributions.
+// Rank (Spearman) correlation of the results is 6/π*asin(rho/2). It panics if rho is outside of [-1, 1].
+func (r *Rand) CorrelatedUniforms(rho float64) (float64, float64) {
+	if !(rho >= -1 && rho <= 1) {
+		panic("invalid argument to CorrelatedUniforms")
+	}
+	x, y := r.CorrelatedNormals(rho)
+	return normalCDF(x), normalCDF(y)
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
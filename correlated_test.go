@@ -0,0 +1,66 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"testing"
+)
+
+func correlation(xs []float64, ys []float64) float64 {
+	var mx, my float64
+	for i := range xs {
+		mx += xs[i]
+		my += ys[i]
+	}
+	mx /= float64(len(xs))
+	my /= float64(len(ys))
+	var sxy, sxx, syy float64
+	for i := range xs {
+		sxy += (xs[i] - mx) * (ys[i] - my)
+		sxx += (xs[i] - mx) * (xs[i] - mx)
+		syy += (ys[i] - my) * (ys[i] - my)
+	}
+	return sxy / math.Sqrt(sxx*syy)
+}
+
+func TestRand_CorrelatedNormals(t *testing.T) {
+	r := rand.New(1)
+	for _, rho := range []float64{-1, -0.5, 0, 0.3, 0.9, 1} {
+		xs := make([]float64, 10000)
+		ys := make([]float64, len(xs))
+		for i := range xs {
+			xs[i], ys[i] = r.CorrelatedNormals(rho)
+		}
+		if c := correlation(xs, ys); math.Abs(c-rho) > 0.05 {
+			t.Errorf("got correlation %v instead of %v", c, rho)
+		}
+		normalCDF := func(x float64) float64 { return 0.5 * math.Erfc(-x/math.Sqrt2) }
+		if d, p := randtest.KolmogorovSmirnov(ys, normalCDF); p < 1e-4 {
+			t.Errorf("rho %v: got D = %v, p = %v", rho, d, p)
+		}
+	}
+}
+
+func TestRand_CorrelatedUniforms(t *testing.T) {
+	r := rand.New(1)
+	const rho = 0.7
+	xs := make([]float64, 10000)
+	ys := make([]float64, len(xs))
+	for i := range xs {
+		xs[i], ys[i] = r.CorrelatedUniforms(rho)
+	}
+	// Pearson correlation of uniforms equals their rank correlation
+	if c, want := correlation(xs, ys), 6/math.Pi*math.Asin(rho/2); math.Abs(c-want) > 0.05 {
+		t.Errorf("got correlation %v instead of %v", c, want)
+	}
+	if err := randtest.CheckUniform(xs, 0, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// Derangement returns, as a slice of n ints, a pseudo-random permutation of the integers
+// in the half-open interval [0, n) without fixed points (p[i] != i for every i), uniformly
+// distributed over all such permutations. It panics if n < 0 or n == 1.
+func (r *Rand) Derangement(n int) []int {
+	if n < 0 || n == 1 {
+		panic("invalid argument to Derangement")
+	}
+	p := make([]int, n)
+	// rejection sampling with early restart: about e attempts are required on average
+	for !r.derange(p) {
+	}
+	return p
+}
+
+func (r *Rand) derange(p []int) bool {
+	for i := range p {
+		p[i] = i
+	}
+	// Fisher–Yates shuffle fixes p[i] at step i, so fixed points can be rejected early
+	i := len(p) - 1
+	for ; i > math.MaxInt32-1; i-- {
+		j := int(r.Uint64n(uint64(i) + 1))
+		p[i], p[j] = p[j], p[i]
+		if p[i] == i {
+			return false
+		}
+	}
+	for ; i > 0; i-- {
+		j := int(r.Uint32n(uint32(i) + 1))
+		p[i], p[j] = p[j], p[i]
+		if p[i] == i {
+			return false
+		}
+	}
+	return len(p) == 0 || p[0] != 0
+}
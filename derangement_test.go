@@ -0,0 +1,43 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"fmt"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Derangement(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Filter(func(n int) bool { return n != 1 }).Draw(t, "n").(int)
+		r := rand.New(s)
+		p := r.Derangement(n)
+		seen := make([]bool, n)
+		for i, v := range p {
+			if v == i || seen[v] {
+				t.Fatalf("got %v which is not a derangement", p)
+			}
+			seen[v] = true
+		}
+	})
+}
+
+func TestRand_DerangementUniform(t *testing.T) {
+	r := rand.New(1)
+	// there are 44 derangements of 5 elements
+	checkUniformOutcomes(t, 44, func() string { return fmt.Sprint(r.Derangement(5)) })
+}
+
+func BenchmarkRand_Derangement(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		sinkInt = r.Derangement(tiny)[0]
+	}
+}
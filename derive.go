@@ -0,0 +1,22 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "hash/fnv"
+
+// Derive returns a new generator determined by the current state of r and the key.
+// Derive does not advance r: deriving generators for
+// different keys from the same master generator produces the same results in any order,
+// and adding a new key does not affect the generators derived for the other ones.
+// Generators derived for different keys are (up to 64-bit hash collisions of the keys) distinct.
+func (r *Rand) Derive(key string) *Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	var d Rand
+	d.init3(r.a^h.Sum64(), r.b+r.w, r.c)
+	return &d
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Derive(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		k1 := rapid.String().Draw(t, "k1").(string)
+		k2 := rapid.String().Draw(t, "k2").(string)
+		r := rand.New(s)
+		state := r.State()
+		a1, b1 := r.Derive(k1), r.Derive(k2)
+		b2, a2 := r.Derive(k2), r.Derive(k1)
+		if r.State() != state {
+			t.Fatalf("Derive advanced the parent generator")
+		}
+		if a1.Uint64() != a2.Uint64() || b1.Uint64() != b2.Uint64() {
+			t.Fatalf("derived generators depend on the order of derivation")
+		}
+		if k1 != k2 && a1.Uint64() == b1.Uint64() {
+			t.Fatalf("got same values for keys %q and %q", k1, k2)
+		}
+		if v := rand.New(s).Uint64(); v == r.Derive(k1).Uint64() {
+			t.Fatalf("derived generator repeats the parent one")
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math"
+	"sort"
+)
+
+// An Empirical generates values distributed according to an empirical distribution,
+// described either by a set of observed values or by a histogram.
+// Empirical is immutable and safe for concurrent use, as long as each goroutine uses its own [Rand].
+type Empirical struct {
+	values      []float64 // sorted observed values, or histogram edges
+	bins        alias     // histogram only
+	interpolate bool
+}
+
+// NewEmpirical returns an Empirical for the observed values. When interpolate is false,
+// the Empirical generates only the observed values (bootstrap resampling); otherwise, it generates
+// values between the observed ones by linearly interpolating the empirical distribution function.
+// NewEmpirical copies the values. It panics if values are empty or contain NaN.
+func NewEmpirical(values []float64, interpolate bool) *Empirical {
+	if len(values) == 0 {
+		panic("invalid argument to NewEmpirical")
+	}
+	e := &Empirical{values: make([]float64, len(values)), interpolate: interpolate}
+	copy(e.values, values)
+	for _, v := range e.values {
+		if math.IsNaN(v) {
+			panic("invalid argument to NewEmpirical")
+		}
+	}
+	sort.Float64s(e.values)
+	return e
+}
+
+// NewHistogram returns an Empirical for the histogram with len(counts) bins, i-th bin being
+// [edges[i], edges[i+1]). It generates values uniformly distributed inside of a bin, choosing bins with
+// probabilities proportional to their counts. NewHistogram copies the edges. It panics if
+// len(edges) != len(counts)+1, edges are not finite and strictly increasing, or counts are not
+// finite non-negative numbers with a positive sum.
+func NewHistogram(edges []float64, counts []float64) *Empirical {
+	if len(counts) == 0 || len(edges) != len(counts)+1 {
+		panic("invalid argument to NewHistogram")
+	}
+	for i, x := range edges {
+		if math.IsNaN(x) || math.IsInf(x, 0) || (i > 0 && !(x > edges[i-1])) {
+			panic("invalid argument to NewHistogram")
+		}
+	}
+	bins, ok := newAlias(counts)
+	if !ok {
+		panic("invalid argument to NewHistogram")
+	}
+	e := &Empirical{values: make([]float64, len(edges)), bins: bins}
+	copy(e.values, edges)
+	return e
+}
+
+// Float64 returns a value drawn from the empirical distribution, using r as the source.
+func (e *Empirical) Float64(r *Rand) float64 {
+	if e.bins.prob != nil {
+		i := e.bins.next(r)
+		lo, hi := e.values[i], e.values[i+1]
+		return lo + r.Float64()*(hi-lo)
+	}
+	n := len(e.values)
+	if !e.interpolate || n == 1 {
+		return e.values[r.Intn(n)]
+	}
+	u := r.Float64() * float64(n-1)
+	i := int(u)
+	return e.values[i] + (u-float64(i))*(e.values[i+1]-e.values[i])
+}
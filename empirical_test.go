@@ -0,0 +1,86 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"pgregory.net/rapid"
+	"sort"
+	"testing"
+)
+
+func TestEmpirical_Observed(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		values := rapid.SliceOfN(rapid.Float64Range(-1e6, 1e6), 1, tiny).Draw(t, "values").([]float64)
+		interpolate := rapid.Bool().Draw(t, "interpolate").(bool)
+		r := rand.New(s)
+		e := rand.NewEmpirical(values, interpolate)
+		sorted := append([]float64(nil), values...)
+		sort.Float64s(sorted)
+		for i := 0; i < 16; i++ {
+			v := e.Float64(r)
+			if v < sorted[0] || v > sorted[len(sorted)-1] {
+				t.Fatalf("got %v outside of [%v, %v]", v, sorted[0], sorted[len(sorted)-1])
+			}
+			if !interpolate {
+				if j := sort.SearchFloat64s(sorted, v); j == len(sorted) || sorted[j] != v {
+					t.Fatalf("got unobserved value %v", v)
+				}
+			}
+		}
+	})
+}
+
+func TestEmpirical_Interpolated(t *testing.T) {
+	r := rand.New(1)
+	e := rand.NewEmpirical([]float64{3, 1, 2, 0, 4}, true)
+	samples := make([]float64, 10000)
+	for i := range samples {
+		samples[i] = e.Float64(r)
+	}
+	if err := randtest.CheckUniform(samples, 0, 4, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	r := rand.New(1)
+	edges := []float64{0, 1, 3, 4}
+	counts := []float64{1, 0, 3}
+	h := rand.NewHistogram(edges, counts)
+	const n = 100000
+	observed := make([]int, len(counts))
+	for i := 0; i < n; i++ {
+		v := h.Float64(r)
+		j := sort.SearchFloat64s(edges, v)
+		if j == 0 || j == len(edges) || edges[j] != v {
+			j--
+		}
+		if j < 0 || j >= len(counts) {
+			t.Fatalf("got %v outside of histogram", v)
+		}
+		observed[j]++
+	}
+	if observed[1] != 0 {
+		t.Fatalf("got %v values in an empty bin", observed[1])
+	}
+	if chi2, p := randtest.ChiSquared([]int{observed[0], observed[2]}, []float64{n / 4, n * 3 / 4}); p < 1e-4 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+}
+
+func BenchmarkHistogram(b *testing.B) {
+	var s float64
+	r := rand.New(1)
+	h := rand.NewHistogram([]float64{0, 1, 2, 3, 4, 5}, []float64{1, 2, 3, 2, 1})
+	for i := 0; i < b.N; i++ {
+		s = h.Float64(r)
+	}
+	sinkFloat64 = s
+}
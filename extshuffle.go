@@ -0,0 +1,132 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+)
+
+// maxOpenBuckets limits the number of temporary files ShuffleLines keeps open at once.
+const maxOpenBuckets = 128
+
+// ShuffleLines writes the lines of src to dst in pseudo-random order, using temporary files
+// in dir (or the default directory for temporary files, if dir is empty) to shuffle more data
+// than fits in memory. Each line is written terminated by '\n', even if the last line of src was not.
+//
+// Lines are first scattered between the given number of temporary buckets, then each bucket
+// is shuffled in memory; buckets should be numerous enough for each of them (about 1/buckets of src)
+// to fit in memory. No more than 128 buckets are open at once: larger numbers are reached
+// by scattering each bucket again. ShuffleLines panics if buckets < 1.
+func (r *Rand) ShuffleLines(dst io.Writer, src io.Reader, buckets int, dir string) error {
+	if buckets < 1 {
+		panic("invalid argument to ShuffleLines")
+	}
+	bw := bufio.NewWriter(dst)
+	if err := r.shuffleLines(bw, src, buckets, dir); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (r *Rand) shuffleLines(dst *bufio.Writer, src io.Reader, buckets int, dir string) (err error) {
+	// "Generation of random permutations of given number of elements using random sampling numbers"
+	// by C. R. Rao (1961) and "A simple randomization procedure" by M. Sandelius (1962):
+	// uniform assignment to buckets, followed by uniform shuffle of each bucket, is a uniform shuffle
+	if buckets == 1 {
+		data, err := io.ReadAll(src)
+		if err != nil {
+			return err
+		}
+		lines := bytes.SplitAfter(data, []byte{'\n'})
+		if last := lines[len(lines)-1]; len(last) == 0 {
+			lines = lines[:len(lines)-1] // empty remainder after the last '\n'
+		} else if last[len(last)-1] != '\n' {
+			lines[len(lines)-1] = append(last, '\n')
+		}
+		r.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+		for _, line := range lines {
+			if _, err := dst.Write(line); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	n := buckets
+	if n > maxOpenBuckets {
+		n = maxOpenBuckets
+	}
+	names := make([]string, 0, n)
+	defer func() {
+		for _, name := range names {
+			_ = os.Remove(name)
+		}
+	}()
+	files := make([]*os.File, n)
+	writers := make([]*bufio.Writer, n)
+	defer func() {
+		for _, f := range files {
+			if f != nil {
+				_ = f.Close()
+			}
+		}
+	}()
+	for i := range files {
+		files[i], err = os.CreateTemp(dir, "rand-shuffle-*")
+		if err != nil {
+			return err
+		}
+		names = append(names, files[i].Name())
+		writers[i] = bufio.NewWriter(files[i])
+	}
+
+	br := bufio.NewReader(src)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if line[len(line)-1] != '\n' {
+				line = append(line, '\n')
+			}
+			if _, err := writers[r.Intn(n)].Write(line); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// close all the buckets before shuffling them, so that the nested levels can open their own
+	for i, f := range files {
+		if err := writers[i].Flush(); err != nil {
+			return err
+		}
+		files[i] = nil
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	sub := (buckets + n - 1) / n
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = r.shuffleLines(dst, f, sub, dir)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gozelle/rand"
+	"os"
+	"pgregory.net/rapid"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestRand_ShuffleLines(t *testing.T) {
+	dir := t.TempDir()
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lines := rapid.SliceOf(rapid.StringMatching(`[a-z]{0,8}`)).Draw(t, "lines").([]string)
+		buckets := rapid.IntRange(1, 8).Draw(t, "buckets").(int)
+		r := rand.New(s)
+		var out bytes.Buffer
+		if err := r.ShuffleLines(&out, strings.NewReader(strings.Join(lines, "\n")), buckets, dir); err != nil {
+			t.Fatal(err)
+		}
+		got := strings.Split(out.String(), "\n")
+		if len(lines) == 0 {
+			if out.Len() != 0 {
+				t.Fatalf("got %q from empty input", out.String())
+			}
+			return
+		}
+		if got[len(got)-1] != "" {
+			t.Fatalf("got %q without trailing newline", out.String())
+		}
+		got = got[:len(got)-1]
+		want := append([]string(nil), lines...)
+		if len(want) > 0 && want[len(want)-1] == "" {
+			want = want[:len(want)-1] // trailing empty line is indistinguishable from trailing newline
+		}
+		sort.Strings(got)
+		sort.Strings(want)
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("got lines %q instead of %q", got, want)
+		}
+	})
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("got %v temporary files left", len(entries))
+	}
+}
+
+func TestRand_ShuffleLinesUniform(t *testing.T) {
+	dir := t.TempDir()
+	r := rand.New(1)
+	checkUniformOutcomes(t, 6, func() string {
+		var out bytes.Buffer
+		if err := r.ShuffleLines(&out, strings.NewReader("a\nb\nc\n"), 2, dir); err != nil {
+			t.Fatal(err)
+		}
+		return out.String()
+	})
+}
+
+func TestRand_ShuffleLinesManyBuckets(t *testing.T) {
+	dir := t.TempDir()
+	var lines []string
+	for i := 0; i < 500; i++ {
+		lines = append(lines, fmt.Sprint(i))
+	}
+	var out bytes.Buffer
+	// more buckets than are kept open at once, to exercise the nested scattering
+	if err := rand.New(1).ShuffleLines(&out, strings.NewReader(strings.Join(lines, "\n")), 1000, dir); err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	sort.Strings(got)
+	sort.Strings(lines)
+	if fmt.Sprint(got) != fmt.Sprint(lines) {
+		t.Fatalf("got lines %q instead of %q", got, lines)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("got %v temporary files left", len(entries))
+	}
+}
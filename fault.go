@@ -0,0 +1,61 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// A FaultInjector decides whether to inject faults into operations, with a configured probability
+// for every kind of fault, for driving chaos testing reproducibly from a seed.
+type FaultInjector struct {
+	r     *Rand
+	salt  uint64
+	probs map[string]float64
+}
+
+// NewFaultInjector returns a FaultInjector injecting each fault with the given probability,
+// using r as the source of randomness. Faults missing from probs are never injected.
+// NewFaultInjector copies probs and draws a value from r. It panics if a probability is not in [0, 1].
+func NewFaultInjector(r *Rand, probs map[string]float64) *FaultInjector {
+	f := &FaultInjector{r: r, salt: r.Uint64(), probs: make(map[string]float64, len(probs))}
+	for fault, p := range probs {
+		if !(p >= 0 && p <= 1) {
+			panic("invalid argument to NewFaultInjector")
+		}
+		f.probs[fault] = p
+	}
+	return f
+}
+
+// Inject reports whether the fault should be injected, drawing a fresh decision every time.
+// Like the [Rand] it uses, Inject is not safe for concurrent use.
+func (f *FaultInjector) Inject(fault string) bool {
+	p := f.probs[fault]
+	return p > 0 && f.r.Float64() < p
+}
+
+// ShouldFail reports whether the fault should be injected into the operation identified by key,
+// e.g. a request ID. The decision depends only on the fault, the key and the state of the generator
+// the FaultInjector was created with, so retries of an operation get the same decision and
+// a run can be replayed exactly. ShouldFail does not use the generator and is safe for concurrent use.
+func (f *FaultInjector) ShouldFail(fault string, key string) bool {
+	p := f.probs[fault]
+	return p > 0 && keyFloat64(keyHash(keyHash(f.salt, fault), key)) < p
+}
+
+// keyHash returns a 64-bit hash of key, seeded with seed.
+func keyHash(seed uint64, key string) uint64 {
+	// FNV-1a, finalized by mix64 to make all bits depend on every byte of key
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= 1099511628211
+	}
+	return mix64(h ^ mix64(seed))
+}
+
+// keyFloat64 maps a hash to a float64 in the half-open interval [0.0, 1.0).
+func keyFloat64(h uint64) float64 {
+	return float64(h>>11) * f53Mul
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var testFaults = map[string]float64{"timeout": 0.1, "error": 0.5, "never": 0}
+
+func checkRate(t *testing.T, name string, n int, p float64, decide func(i int) bool) {
+	t.Helper()
+	hits := 0
+	for i := 0; i < n; i++ {
+		if decide(i) {
+			hits++
+		}
+	}
+	if p == 0 && hits != 0 || p > 0 && math.Abs(float64(hits)-float64(n)*p)/math.Sqrt(float64(n)*p*(1-p)) > 5 {
+		t.Errorf("%v: got %v of %v with probability %v", name, hits, n, p)
+	}
+}
+
+func TestFaultInjector_Inject(t *testing.T) {
+	f := rand.NewFaultInjector(rand.New(1), testFaults)
+	for fault, p := range testFaults {
+		checkRate(t, fault, 10000, p, func(int) bool { return f.Inject(fault) })
+	}
+	checkRate(t, "unknown", 100, 0, func(int) bool { return f.Inject("unknown") })
+}
+
+func TestFaultInjector_ShouldFail(t *testing.T) {
+	f1 := rand.NewFaultInjector(rand.New(1), testFaults)
+	f2 := rand.NewFaultInjector(rand.New(1), testFaults)
+	f3 := rand.NewFaultInjector(rand.New(2), testFaults)
+	same, differ := true, false
+	for fault, p := range testFaults {
+		checkRate(t, fault, 10000, p, func(i int) bool { return f1.ShouldFail(fault, strconv.Itoa(i)) })
+		for i := 0; i < 100; i++ {
+			key := strconv.Itoa(i)
+			same = same && f1.ShouldFail(fault, key) == f2.ShouldFail(fault, key) && f1.ShouldFail(fault, key) == f1.ShouldFail(fault, key)
+			differ = differ || f1.ShouldFail(fault, key) != f3.ShouldFail(fault, key)
+		}
+	}
+	if !same {
+		t.Error("decisions differ between injectors created from the same seed")
+	}
+	if !differ {
+		t.Error("decisions are the same for injectors created from different seeds")
+	}
+}
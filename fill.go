@@ -0,0 +1,249 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	fillMaxLen   = 8
+	fillMaxDepth = 8
+	fillAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// fillOpts holds the constraints parsed from a `rand` struct tag.
+type fillOpts struct {
+	skip           bool
+	min, max       string // parsed according to the kind of the value
+	hasMin, hasMax bool
+	minLen, maxLen int
+	hasMaxLen      bool
+}
+
+// Fill populates the value ptr points to with pseudo-random data. Supported kinds are booleans,
+// integers, floats, complex numbers, strings, arrays, slices, maps, pointers and structs
+// (recursively); interfaces, channels and functions are left untouched, as are unexported struct fields.
+//
+// Struct fields can be constrained with the `rand` tag, containing comma-separated options:
+//
+//	min=N, max=N          bounds for numbers, inclusive for integers, [min, max) for floats
+//	len=N                 exact length of strings, slices and maps
+//	minlen=N, maxlen=N    bounds of the length of strings, slices and maps (default 0 and max(minlen, 8))
+//	-                     do not touch the field
+//
+// Strings consist of ASCII letters and digits. Fill panics if ptr is not a non-nil pointer,
+// if a tag is malformed, or if its bounds cannot be represented by the type of the field.
+func Fill(r *Rand, ptr interface{}) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic("invalid argument to Fill")
+	}
+	fillValue(r, v.Elem(), fillOpts{maxLen: fillMaxLen}, 0)
+}
+
+func fillValue(r *Rand, v reflect.Value, o fillOpts, depth int) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.Bits(1) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lo, hi := o.intBounds(v.Type())
+		if uint64(hi)-uint64(lo) == math.MaxUint64 {
+			v.SetInt(int64(r.next64()))
+		} else {
+			v.SetInt(lo + int64(r.Uint64n(uint64(hi)-uint64(lo)+1)))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lo, hi := o.uintBounds(v.Type())
+		if hi-lo == math.MaxUint64 {
+			v.SetUint(r.next64())
+		} else {
+			v.SetUint(lo + r.Uint64n(hi-lo+1))
+		}
+	case reflect.Float32, reflect.Float64:
+		lo, hi := o.floatBounds(v.Type())
+		v.SetFloat(lo + r.Float64()*(hi-lo))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(r.NormFloat64(), r.NormFloat64()))
+	case reflect.String:
+		b := make([]byte, fillLen(r, o))
+		for i := range b {
+			b[i] = fillAlphabet[r.Uint32n(uint32(len(fillAlphabet)))]
+		}
+		v.SetString(string(b))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillValue(r, v.Index(i), o, depth+1)
+		}
+	case reflect.Slice:
+		if depth >= fillMaxDepth {
+			return
+		}
+		n := fillLen(r, o)
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			fillValue(r, s.Index(i), o, depth+1)
+		}
+		v.Set(s)
+	case reflect.Map:
+		if depth >= fillMaxDepth {
+			return
+		}
+		n := fillLen(r, o)
+		m := reflect.MakeMapWithSize(v.Type(), n)
+		for i := 0; i < n; i++ {
+			// duplicate keys make the map smaller than n, which is fine
+			k := reflect.New(v.Type().Key()).Elem()
+			fillValue(r, k, o, depth+1)
+			e := reflect.New(v.Type().Elem()).Elem()
+			fillValue(r, e, o, depth+1)
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	case reflect.Ptr:
+		if depth >= fillMaxDepth {
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		fillValue(r, p.Elem(), o, depth+1)
+		v.Set(p)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue // unexported
+			}
+			fo := parseFillTag(f.Tag.Get("rand"))
+			if !fo.skip {
+				fillValue(r, v.Field(i), fo, depth+1)
+			}
+		}
+	}
+}
+
+// intBounds returns the inclusive bounds for a value of signed integer type t.
+func (o fillOpts) intBounds(t reflect.Type) (lo int64, hi int64) {
+	lo, hi = int64(math.MinInt64)>>(64-t.Bits()), int64(math.MaxInt64)>>(64-t.Bits())
+	var err error
+	if o.hasMin {
+		if lo, err = strconv.ParseInt(o.min, 10, t.Bits()); err != nil {
+			panic(fillBoundError("min", o.min, t))
+		}
+	}
+	if o.hasMax {
+		if hi, err = strconv.ParseInt(o.max, 10, t.Bits()); err != nil {
+			panic(fillBoundError("max", o.max, t))
+		}
+	}
+	if lo > hi {
+		panic("rand: inconsistent tag bounds for " + t.String())
+	}
+	return lo, hi
+}
+
+// uintBounds returns the inclusive bounds for a value of unsigned integer type t.
+func (o fillOpts) uintBounds(t reflect.Type) (lo uint64, hi uint64) {
+	lo, hi = 0, uint64(math.MaxUint64)>>(64-t.Bits())
+	var err error
+	if o.hasMin {
+		if lo, err = strconv.ParseUint(o.min, 10, t.Bits()); err != nil {
+			panic(fillBoundError("min", o.min, t))
+		}
+	}
+	if o.hasMax {
+		if hi, err = strconv.ParseUint(o.max, 10, t.Bits()); err != nil {
+			panic(fillBoundError("max", o.max, t))
+		}
+	}
+	if lo > hi {
+		panic("rand: inconsistent tag bounds for " + t.String())
+	}
+	return lo, hi
+}
+
+// floatBounds returns the bounds of the half-open interval for a value of floating-point type t.
+func (o fillOpts) floatBounds(t reflect.Type) (lo float64, hi float64) {
+	lo, hi = 0, 1
+	var err error
+	if o.hasMin {
+		if lo, err = strconv.ParseFloat(o.min, t.Bits()); err != nil {
+			panic(fillBoundError("min", o.min, t))
+		}
+	}
+	if o.hasMax {
+		if hi, err = strconv.ParseFloat(o.max, t.Bits()); err != nil {
+			panic(fillBoundError("max", o.max, t))
+		}
+	}
+	if lo > hi {
+		panic("rand: inconsistent tag bounds for " + t.String())
+	}
+	return lo, hi
+}
+
+func fillBoundError(key string, val string, t reflect.Type) string {
+	return "rand: tag option " + strconv.Quote(key+"="+val) + " is malformed or out of range for " + t.String()
+}
+
+func fillLen(r *Rand, o fillOpts) int {
+	return o.minLen + r.Intn(o.maxLen-o.minLen+1)
+}
+
+func parseFillTag(tag string) fillOpts {
+	o := fillOpts{maxLen: fillMaxLen}
+	if tag == "" {
+		return o
+	}
+	if tag == "-" {
+		o.skip = true
+		return o
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 {
+			panic("rand: malformed tag option " + strconv.Quote(opt))
+		}
+		key, val := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch key {
+		case "min", "max":
+			if _, err := strconv.ParseFloat(val, 64); err != nil {
+				panic("rand: malformed tag option " + strconv.Quote(opt))
+			}
+			if key == "min" {
+				o.min, o.hasMin = val, true
+			} else {
+				o.max, o.hasMax = val, true
+			}
+		case "len", "minlen", "maxlen":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 0 {
+				panic("rand: malformed tag option " + strconv.Quote(opt))
+			}
+			switch key {
+			case "len":
+				o.minLen, o.maxLen, o.hasMaxLen = n, n, true
+			case "minlen":
+				o.minLen = n
+			case "maxlen":
+				o.maxLen, o.hasMaxLen = n, true
+			}
+		default:
+			panic("rand: unknown tag option " + strconv.Quote(opt))
+		}
+	}
+	if !o.hasMaxLen && o.minLen > o.maxLen {
+		o.maxLen = o.minLen
+	}
+	if o.minLen > o.maxLen {
+		panic("rand: inconsistent tag " + strconv.Quote(tag))
+	}
+	return o
+}
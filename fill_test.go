@@ -0,0 +1,134 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"reflect"
+	"testing"
+)
+
+type fillInner struct {
+	Name  string  `rand:"len=4"`
+	Score float64 `rand:"min=10,max=20"`
+}
+
+type fillOuter struct {
+	Age      int8  `rand:"min=18,max=99"`
+	Count    uint8 `rand:"max=3"`
+	Big      int64
+	Flag     bool
+	Tags     []string       `rand:"minlen=1,maxlen=3"`
+	Attrs    map[string]int `rand:"len=2"`
+	Inner    fillInner
+	InnerPtr *fillInner
+	Grid     [2][3]uint16
+	Skipped  int `rand:"-"`
+	Self     *fillOuter
+	hidden   int
+}
+
+func TestFill(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		var v fillOuter
+		v.Skipped = 42
+		rand.Fill(rand.New(s), &v)
+		if v.Age < 18 || v.Age > 99 {
+			t.Fatalf("got age %v outside of [18, 99]", v.Age)
+		}
+		if v.Count > 3 {
+			t.Fatalf("got count %v above 3", v.Count)
+		}
+		if len(v.Tags) < 1 || len(v.Tags) > 3 {
+			t.Fatalf("got %v tags outside of [1, 3]", len(v.Tags))
+		}
+		if len(v.Attrs) > 2 {
+			t.Fatalf("got %v attrs above 2", len(v.Attrs))
+		}
+		for _, in := range []*fillInner{&v.Inner, v.InnerPtr} {
+			if in == nil {
+				t.Fatalf("got nil inner pointer")
+			}
+			if len(in.Name) != 4 {
+				t.Fatalf("got name %q of length other than 4", in.Name)
+			}
+			if in.Score < 10 || in.Score >= 20 {
+				t.Fatalf("got score %v outside of [10, 20)", in.Score)
+			}
+		}
+		if v.Skipped != 42 || v.hidden != 0 {
+			t.Fatalf("got skipped fields modified")
+		}
+	})
+}
+
+func TestFill_Deterministic(t *testing.T) {
+	var v1, v2 fillOuter
+	rand.Fill(rand.New(1), &v1)
+	rand.Fill(rand.New(1), &v2)
+	if !reflect.DeepEqual(v1, v2) {
+		t.Fatalf("got different values for the same seed")
+	}
+}
+
+func TestFill_LargeIntBounds(t *testing.T) {
+	var v struct {
+		U uint64 `rand:"min=18446744073709551615"`
+		I int64  `rand:"min=9007199254740993,max=9007199254740993"`
+	}
+	rand.Fill(rand.New(1), &v)
+	if v.U != 1<<64-1 || v.I != 1<<53+1 {
+		t.Fatalf("got %v and %v instead of the exact bounds", v.U, v.I)
+	}
+}
+
+func TestFill_BoundsOutOfRange(t *testing.T) {
+	fills := map[string]func(r *rand.Rand){
+		"uint8 max=300": func(r *rand.Rand) {
+			var v struct {
+				X uint8 `rand:"max=300"`
+			}
+			rand.Fill(r, &v)
+		},
+		"uint min=-5": func(r *rand.Rand) {
+			var v struct {
+				X uint `rand:"min=-5"`
+			}
+			rand.Fill(r, &v)
+		},
+		"int8 min=-129": func(r *rand.Rand) {
+			var v struct {
+				X int8 `rand:"min=-129"`
+			}
+			rand.Fill(r, &v)
+		},
+		"int max=1.5": func(r *rand.Rand) {
+			var v struct {
+				X int `rand:"max=1.5"`
+			}
+			rand.Fill(r, &v)
+		},
+		"int min>max": func(r *rand.Rand) {
+			var v struct {
+				X int `rand:"min=2,max=1"`
+			}
+			rand.Fill(r, &v)
+		},
+	}
+	for name, fill := range fills {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v: Fill did not panic", name)
+				}
+			}()
+			fill(rand.New(1))
+		}()
+	}
+}
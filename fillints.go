@@ -0,0 +1,69 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+// integer is the same as golang.org/x/exp/constraints.Integer,
+// without adding the dependency for users of the package.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// FillInts fills dst with uniformly distributed pseudo-random values of T, covering its whole range.
+// Values narrower than 64 bits are cut from a single 64-bit value, so that e.g. filling a []uint8
+// consumes one 64-bit value per 8 elements.
+//
+// When r is nil, FillInts uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func FillInts[T integer](r *Rand, dst []T) {
+	width := 0
+	for x := T(1); x != 0; x <<= 1 {
+		width++
+	}
+	for i := 0; i < len(dst); {
+		var v uint64
+		if r == nil {
+			v = Uint64()
+		} else {
+			v = r.next64()
+		}
+		for k := 0; k < 64/width && i < len(dst); k++ {
+			dst[i] = T(v)
+			v >>= width
+			i++
+		}
+	}
+}
+
+// FillIntsN fills dst with uniformly distributed pseudo-random values in the half-open interval [0, n).
+// It panics if n <= 0.
+//
+// When r is nil, FillIntsN uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func FillIntsN[T integer](r *Rand, dst []T, n T) {
+	if n <= 0 {
+		panic("invalid argument to FillIntsN")
+	}
+	if uint64(n) <= 1<<32-1 {
+		for i := range dst {
+			if r == nil {
+				dst[i] = T(Uint32n(uint32(n)))
+			} else {
+				dst[i] = T(r.Uint32n(uint32(n)))
+			}
+		}
+		return
+	}
+	for i := range dst {
+		if r == nil {
+			dst[i] = T(Uint64n(uint64(n)))
+		} else {
+			dst[i] = T(r.Uint64n(uint64(n)))
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestFillInts_Bytes(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		got := make([]uint8, n)
+		rand.FillInts(rand.New(s), got)
+		want := make([]byte, n)
+		_, _ = rand.New(s).Read(want)
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("got %v instead of %v at %v", got[i], want[i], i)
+			}
+		}
+	})
+}
+
+func TestFillInts_Signed(t *testing.T) {
+	dst := make([]int16, 100000)
+	rand.FillInts(rand.New(1), dst)
+	observed := make([]int, 4)
+	for _, v := range dst {
+		observed[(int(v)-math.MinInt16)>>14]++
+	}
+	if _, p := randtest.ChiSquared(observed, []float64{25000, 25000, 25000, 25000}); p < 1e-4 {
+		t.Errorf("got p-value %v for %v", p, observed)
+	}
+}
+
+func TestFillIntsN(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.Int64Range(1, math.MaxInt64).Draw(t, "n").(int64)
+		dst := make([]int64, tiny)
+		rand.FillIntsN(rand.New(s), dst, n)
+		for _, v := range dst {
+			if v < 0 || v >= n {
+				t.Fatalf("got %v outside of [0, %v)", v, n)
+			}
+		}
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// InRect returns a point uniformly distributed inside the rectangle with opposite corners min and max.
+// It panics if the coordinates of min are greater than the ones of max.
+func (r *Rand) InRect(min [2]float64, max [2]float64) [2]float64 {
+	if !(min[0] <= max[0]) || !(min[1] <= max[1]) {
+		panic("invalid argument to InRect")
+	}
+	return [2]float64{
+		min[0] + r.Float64()*(max[0]-min[0]),
+		min[1] + r.Float64()*(max[1]-min[1]),
+	}
+}
+
+// InCircle returns a point uniformly distributed inside the circle with the given radius centered at the origin.
+// It panics if radius < 0.
+func (r *Rand) InCircle(radius float64) [2]float64 {
+	if !(radius >= 0) {
+		panic("invalid argument to InCircle")
+	}
+	for {
+		// rejection sampling is faster than the polar method, and is free of the sqrt bias pitfall
+		x, y := 2*r.Float64()-1, 2*r.Float64()-1
+		if x*x+y*y < 1 {
+			return [2]float64{radius * x, radius * y}
+		}
+	}
+}
+
+// InSphere returns a point uniformly distributed inside the sphere with the given radius centered at the origin.
+// It panics if radius < 0.
+func (r *Rand) InSphere(radius float64) [3]float64 {
+	if !(radius >= 0) {
+		panic("invalid argument to InSphere")
+	}
+	for {
+		x, y, z := 2*r.Float64()-1, 2*r.Float64()-1, 2*r.Float64()-1
+		if x*x+y*y+z*z < 1 {
+			return [3]float64{radius * x, radius * y, radius * z}
+		}
+	}
+}
+
+// OnSphere returns a point uniformly distributed on the surface of the sphere with the given radius
+// centered at the origin. It panics if radius < 0.
+func (r *Rand) OnSphere(radius float64) [3]float64 {
+	if !(radius >= 0) {
+		panic("invalid argument to OnSphere")
+	}
+	// "Choosing a Point from the Surface of a Sphere" by George Marsaglia
+	for {
+		x, y := 2*r.Float64()-1, 2*r.Float64()-1
+		s := x*x + y*y
+		if s < 1 {
+			q := 2 * math.Sqrt(1-s)
+			return [3]float64{radius * x * q, radius * y * q, radius * (1 - 2*s)}
+		}
+	}
+}
+
+// UnitVector fills dst with a vector of unit length pointing in a uniformly distributed direction
+// in len(dst)-dimensional space. It panics if dst is empty.
+func (r *Rand) UnitVector(dst []float64) {
+	if len(dst) == 0 {
+		panic("invalid argument to UnitVector")
+	}
+	for {
+		// multivariate standard normal distribution is spherically symmetric
+		r.NormFloat64s(dst)
+		var norm float64
+		for _, x := range dst {
+			norm += x * x
+		}
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for i := range dst {
+				dst[i] /= norm
+			}
+			return
+		}
+	}
+}
+
+// Simplex fills dst with a vector uniformly distributed over the standard (len(dst)-1)-simplex:
+// its elements are non-negative and sum to 1. It panics if dst is empty.
+func (r *Rand) Simplex(dst []float64) {
+	if len(dst) == 0 {
+		panic("invalid argument to Simplex")
+	}
+	for {
+		// normalized exponentials are Dirichlet(1, ..., 1) distributed; normalizing sorted uniforms is not
+		r.ExpFloat64s(dst)
+		var sum float64
+		for _, x := range dst {
+			sum += x
+		}
+		if sum > 0 {
+			for i := range dst {
+				dst[i] /= sum
+			}
+			return
+		}
+	}
+}
+
+// InPolygon returns a point uniformly distributed inside the convex polygon with the given vertices,
+// listed in either clockwise or counterclockwise order. It panics if there are less than 3 vertices,
+// or the polygon has zero area.
+func (r *Rand) InPolygon(vertices [][2]float64) [2]float64 {
+	if len(vertices) < 3 {
+		panic("invalid argument to InPolygon")
+	}
+	// fan triangulation from the first vertex is valid for convex polygons
+	var total float64
+	for i := 2; i < len(vertices); i++ {
+		total += triangleArea(vertices[0], vertices[i-1], vertices[i])
+	}
+	if !(total > 0) || math.IsInf(total, 1) {
+		panic("invalid argument to InPolygon")
+	}
+	u := r.Float64() * total
+	i := 2
+	for ; i < len(vertices)-1; i++ {
+		a := triangleArea(vertices[0], vertices[i-1], vertices[i])
+		if u < a {
+			break
+		}
+		u -= a
+	}
+	a, b, c := vertices[0], vertices[i-1], vertices[i]
+	s, t := r.Float64(), r.Float64()
+	if s+t > 1 {
+		s, t = 1-s, 1-t
+	}
+	return [2]float64{
+		a[0] + s*(b[0]-a[0]) + t*(c[0]-a[0]),
+		a[1] + s*(b[1]-a[1]) + t*(c[1]-a[1]),
+	}
+}
+
+func triangleArea(a [2]float64, b [2]float64, c [2]float64) float64 {
+	return math.Abs((b[0]-a[0])*(c[1]-a[1])-(c[0]-a[0])*(b[1]-a[1])) / 2
+}
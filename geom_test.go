@@ -0,0 +1,164 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_InRect(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		x0 := rapid.Float64Range(-1e6, 1e6).Draw(t, "x0").(float64)
+		y0 := rapid.Float64Range(-1e6, 1e6).Draw(t, "y0").(float64)
+		w := rapid.Float64Range(0, 1e6).Draw(t, "w").(float64)
+		h := rapid.Float64Range(0, 1e6).Draw(t, "h").(float64)
+		r := rand.New(s)
+		p := r.InRect([2]float64{x0, y0}, [2]float64{x0 + w, y0 + h})
+		if p[0] < x0 || p[0] > x0+w || p[1] < y0 || p[1] > y0+h {
+			t.Fatalf("got %v outside of the rectangle", p)
+		}
+	})
+}
+
+func TestRand_InCircle(t *testing.T) {
+	r := rand.New(1)
+	// squared distance from the center of a uniformly distributed point is uniform
+	samples := make([]float64, 10000)
+	for i := range samples {
+		p := r.InCircle(2)
+		samples[i] = (p[0]*p[0] + p[1]*p[1]) / 4
+	}
+	if err := randtest.CheckUniform(samples, 0, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRand_InSphere(t *testing.T) {
+	r := rand.New(1)
+	// cubed distance from the center of a uniformly distributed point is uniform
+	samples := make([]float64, 10000)
+	for i := range samples {
+		p := r.InSphere(2)
+		samples[i] = math.Pow((p[0]*p[0]+p[1]*p[1]+p[2]*p[2])/4, 1.5)
+	}
+	if err := randtest.CheckUniform(samples, 0, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRand_OnSphere(t *testing.T) {
+	r := rand.New(1)
+	// by Archimedes' hat-box theorem, each coordinate of a uniformly distributed point is uniform
+	samples := make([]float64, 10000)
+	for i := range samples {
+		p := r.OnSphere(2)
+		if d := math.Sqrt(p[0]*p[0] + p[1]*p[1] + p[2]*p[2]); math.Abs(d-2) > 1e-12 {
+			t.Fatalf("got %v at distance %v", p, d)
+		}
+		samples[i] = p[i%3]
+	}
+	if err := randtest.CheckUniform(samples, -2, 2, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkRand_OnSphere(b *testing.B) {
+	var s float64
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		s = r.OnSphere(1)[2]
+	}
+	sinkFloat64 = s
+}
+
+func TestRand_UnitVector(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, tiny).Draw(t, "n").(int)
+		r := rand.New(s)
+		v := make([]float64, n)
+		r.UnitVector(v)
+		var norm float64
+		for _, x := range v {
+			norm += x * x
+		}
+		if math.Abs(norm-1) > 1e-12 {
+			t.Fatalf("got %v with squared norm %v", v, norm)
+		}
+	})
+}
+
+func TestRand_UnitVectorUniform(t *testing.T) {
+	r := rand.New(1)
+	// in 3 dimensions, each coordinate of a uniformly distributed direction is uniform
+	v := make([]float64, 3)
+	samples := make([]float64, 10000)
+	for i := range samples {
+		r.UnitVector(v)
+		samples[i] = v[i%3]
+	}
+	if err := randtest.CheckUniform(samples, -1, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRand_Simplex(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, tiny).Draw(t, "n").(int)
+		r := rand.New(s)
+		v := make([]float64, n)
+		r.Simplex(v)
+		var sum float64
+		for _, x := range v {
+			if x < 0 {
+				t.Fatalf("got negative element in %v", v)
+			}
+			sum += x
+		}
+		if math.Abs(sum-1) > 1e-12 {
+			t.Fatalf("got %v with sum %v", v, sum)
+		}
+	})
+}
+
+func TestRand_SimplexUniform(t *testing.T) {
+	r := rand.New(1)
+	// marginal distribution of an element of uniform 2-simplex is Beta(1, 2)
+	v := make([]float64, 3)
+	samples := make([]float64, 10000)
+	for i := range samples {
+		r.Simplex(v)
+		samples[i] = v[i%3]
+	}
+	if d, p := randtest.KolmogorovSmirnov(samples, func(x float64) float64 { return 1 - (1-x)*(1-x) }); p < 1e-4 {
+		t.Errorf("got D = %v, p = %v", d, p)
+	}
+}
+
+func TestRand_InPolygon(t *testing.T) {
+	r := rand.New(1)
+	// unit square with an extra vertex, fanned into triangles of areas 1/4, 1/4 and 1/2
+	square := [][2]float64{{0, 0}, {1, 0}, {1, 0.5}, {1, 1}, {0, 1}}
+	xs := make([]float64, 10000)
+	ys := make([]float64, len(xs))
+	for i := range xs {
+		p := r.InPolygon(square)
+		xs[i], ys[i] = p[0], p[1]
+	}
+	if err := randtest.CheckUniform(xs, 0, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+	if err := randtest.CheckUniform(ys, 0, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
@@ -8,6 +8,7 @@ package rand
 
 import (
 	"encoding/binary"
+	"io"
 	"math"
 	"math/bits"
 )
@@ -23,6 +24,7 @@ func Float64() float64 {
 }
 
 // Int returns a uniformly distributed non-negative pseudo-random int.
+// On 32-bit platforms, it has only 31 bits of randomness; use [Int63] for 63 bits on all platforms.
 func Int() int {
 	return int(rand64() & intMask)
 }
@@ -98,6 +100,7 @@ func perm(p []int) {
 // Read generates len(p) pseudo-random bytes and writes them into p. It always returns len(p) and a nil error.
 func Read(p []byte) (n int, err error) {
 	// see Rand.Read
+	countRead(len(p))
 	for ; n+8 <= len(p); n += 8 {
 		binary.LittleEndian.PutUint64(p[n:n+8], rand64())
 	}
@@ -111,6 +114,18 @@ func Read(p []byte) (n int, err error) {
 	return
 }
 
+// Reader is a global, shared instance of a non-deterministic pseudo-random byte stream,
+// with the same shape as crypto/rand.Reader. Reader is backed by [Read] and is safe for
+// concurrent use by multiple goroutines. Unlike crypto/rand.Reader, it is unsuitable
+// for security-sensitive work.
+var Reader io.Reader = globalReader{}
+
+type globalReader struct{}
+
+func (globalReader) Read(p []byte) (n int, err error) {
+	return Read(p)
+}
+
 // Shuffle pseudo-randomizes the order of elements. n is the number of elements. Shuffle panics if n < 0.
 // swap swaps the elements with indexes i and j.
 //
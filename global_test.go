@@ -126,3 +126,14 @@ func TestUint64n(t *testing.T) {
 		}
 	})
 }
+
+func TestReader(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		buf := make([]byte, n)
+		m, err := rand.Reader.Read(buf)
+		if m != n || err != nil {
+			t.Fatalf("got (%v, %v) instead of (%v, nil)", m, err, n)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+)
+
+// A Hedged wraps a [Rand], mixing fresh entropy from crypto/rand into its state every few
+// generated values. If the state of the generator leaks (e.g. because it was seeded with
+// a guessable value), its output becomes unpredictable again after the next reseed.
+// Hedged does not make the generator suitable for security-sensitive work.
+// A Hedged is not safe for concurrent use.
+type Hedged struct {
+	r     *Rand
+	every uint64
+	next  uint64 // SFC64 counter value at which to reseed
+}
+
+// NewHedged returns a Hedged drawing values from r, which mixes entropy into r
+// before the first draw and after every 'every' 64-bit values generated.
+// The cost of a reseed is about a microsecond, so every of 2^16 or more keeps the overhead negligible.
+// NewHedged panics if every == 0.
+func NewHedged(r *Rand, every uint64) *Hedged {
+	if every == 0 {
+		panic("invalid argument to NewHedged")
+	}
+	return &Hedged{r: r, every: every, next: r.w}
+}
+
+func (h *Hedged) check() {
+	// SFC64 counter w is incremented once per generated word
+	if h.r.w-h.next < 1<<63 {
+		h.reseed()
+	}
+}
+
+func (h *Hedged) reseed() {
+	var e [24]byte
+	if _, err := cryptorand.Read(e[:]); err != nil {
+		panic("rand: failed to read entropy: " + err.Error())
+	}
+	s := &h.r.sfc64
+	s.a ^= binary.LittleEndian.Uint64(e[0:])
+	s.b ^= binary.LittleEndian.Uint64(e[8:])
+	s.c ^= binary.LittleEndian.Uint64(e[16:])
+	for i := 0; i < 12; i++ {
+		s.next64()
+	}
+	h.next = s.w + h.every
+}
+
+// ExpFloat64 calls [Rand.ExpFloat64], reseeding first if due.
+func (h *Hedged) ExpFloat64() float64 {
+	h.check()
+	return h.r.ExpFloat64()
+}
+
+// Float64 calls [Rand.Float64], reseeding first if due.
+func (h *Hedged) Float64() float64 {
+	h.check()
+	return h.r.Float64()
+}
+
+// Int calls [Rand.Int], reseeding first if due.
+func (h *Hedged) Int() int {
+	h.check()
+	return h.r.Int()
+}
+
+// Int63 calls [Rand.Int63], reseeding first if due.
+func (h *Hedged) Int63() int64 {
+	h.check()
+	return h.r.Int63()
+}
+
+// Int63n calls [Rand.Int63n], reseeding first if due.
+func (h *Hedged) Int63n(n int64) int64 {
+	h.check()
+	return h.r.Int63n(n)
+}
+
+// Intn calls [Rand.Intn], reseeding first if due.
+func (h *Hedged) Intn(n int) int {
+	h.check()
+	return h.r.Intn(n)
+}
+
+// NormFloat64 calls [Rand.NormFloat64], reseeding first if due.
+func (h *Hedged) NormFloat64() float64 {
+	h.check()
+	return h.r.NormFloat64()
+}
+
+// Read calls [Rand.Read], reseeding first if due.
+// Large reads are split, so that a reseed happens at least every 'every' generated values.
+func (h *Hedged) Read(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		h.check()
+		chunk := p
+		if left := h.next - h.r.w; uint64(len(chunk))/8 > left {
+			chunk = chunk[:left*8]
+		}
+		m, _ := h.r.Read(chunk)
+		n += m
+		p = p[m:]
+	}
+	return n, nil
+}
+
+// Uint32 calls [Rand.Uint32], reseeding first if due.
+func (h *Hedged) Uint32() uint32 {
+	h.check()
+	return h.r.Uint32()
+}
+
+// Uint32n calls [Rand.Uint32n], reseeding first if due.
+func (h *Hedged) Uint32n(n uint32) uint32 {
+	h.check()
+	return h.r.Uint32n(n)
+}
+
+// Uint64 calls [Rand.Uint64], reseeding first if due.
+func (h *Hedged) Uint64() uint64 {
+	h.check()
+	return h.r.Uint64()
+}
+
+// Uint64n calls [Rand.Uint64n], reseeding first if due.
+func (h *Hedged) Uint64n(n uint64) uint64 {
+	h.check()
+	return h.r.Uint64n(n)
+}
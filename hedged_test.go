@@ -0,0 +1,51 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+func TestHedged(t *testing.T) {
+	const every = 16
+	r1 := rand.New(1)
+	r2 := rand.New(1)
+	var d rand.Drawer = rand.NewHedged(r1, every)
+	if d.Uint64() == r2.Uint64() {
+		t.Fatal("hedged generator was not reseeded before the first draw")
+	}
+	// between reseeds, the hedged generator must produce the output of the underlying one
+	r2 = rand.New(0)
+	for i := 1; i < every; i++ {
+		_ = r2.SetState(r1.State())
+		if v1, v2 := d.Uint64(), r2.Uint64(); v1 != v2 {
+			t.Fatalf("got %v instead of %v at %v", v1, v2, i)
+		}
+	}
+	_ = r2.SetState(r1.State())
+	if d.Uint64() == r2.Uint64() {
+		t.Fatalf("hedged generator was not reseeded after %v draws", every)
+	}
+}
+
+func TestHedged_Read(t *testing.T) {
+	h := rand.NewHedged(rand.New(1), 3)
+	for _, n := range []int{0, 1, 7, 8, 23, 24, 25, 1000} {
+		p := make([]byte, n)
+		if m, err := h.Read(p); m != n || err != nil {
+			t.Fatalf("Read(%v bytes) returned %v, %v", n, m, err)
+		}
+	}
+}
+
+func BenchmarkHedged_Uint64(b *testing.B) {
+	h := rand.NewHedged(rand.New(1), 1<<16)
+	for i := 0; i < b.N; i++ {
+		sinkUint64 = h.Uint64()
+	}
+}
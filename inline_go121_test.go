@@ -0,0 +1,64 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// inlining decisions depend on the compiler version and the register size,
+// so the list below is only checked with recent compilers on 64-bit platforms
+
+//go:build go1.21 && (amd64 || arm64)
+
+package rand_test
+
+import (
+	"os/exec"
+	"regexp"
+	"testing"
+)
+
+// hot path functions that must stay within the inlining budget;
+// performance of call-overhead-dominated code depends on it
+var mustInline = []string{
+	"(*sfc64).next64",
+	"(*Rand).next32",
+	"(*Rand).Float32",
+	"(*Rand).Float64",
+	"(*Rand).Int",
+	"(*Rand).Int31",
+	"(*Rand).Int31n",
+	"(*Rand).Int63",
+	"(*Rand).Int63n",
+	"(*Rand).Intn",
+	"(*Rand).Uint32",
+	"(*Rand).Uint32n",
+	"(*Rand).Uint64",
+	"Float32",
+	"Float64",
+	"Int",
+	"Int31",
+	"Int63",
+	"Uint32",
+	"Uint32n",
+	"Uint64",
+}
+
+func TestInlining(t *testing.T) {
+	if testing.Short() {
+		t.Skip("inlining test requires the go tool")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skipf("go tool not found: %v", err)
+	}
+	out, err := exec.Command(goTool, "build", "-gcflags=-m", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to build: %v\n%s", err, out)
+	}
+	for _, fn := range mustInline {
+		re := regexp.MustCompile(`(?m): can inline ` + regexp.QuoteMeta(fn) + `( with cost \d+)?$`)
+		if !re.Match(out) {
+			t.Errorf("%v is no longer inlinable", fn)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// DrawHook is called by [Instrumented] for every value drawn through it.
+type DrawHook interface {
+	// OnDraw is called with the name of the method and its bound argument (0 for unbounded methods).
+	OnDraw(method string, bound uint64)
+}
+
+// DrawStats are the counters maintained by [Instrumented].
+type DrawStats struct {
+	// Draws is the number of values drawn through the Instrumented.
+	Draws uint64
+	// Words is the number of 64-bit words of generator output these draws consumed.
+	Words uint64
+	// ForeignWords is the number of 64-bit words consumed from the same generator by someone else
+	// between the draws; non-zero value indicates the generator is shared with other components.
+	// Words consumed before the generator is reseeded between the draws are not counted.
+	ForeignWords uint64
+}
+
+// An Instrumented wraps a [Rand], counting the values drawn through it
+// and optionally reporting every draw to a hook. Wrapping the generator
+// of each subsystem allows to audit how much randomness it consumes.
+type Instrumented struct {
+	r     *Rand
+	hook  DrawHook
+	stats DrawStats
+	last  uint64 // SFC64 counter after the last draw
+}
+
+// NewInstrumented returns an Instrumented drawing values from r. hook can be nil.
+func NewInstrumented(r *Rand, hook DrawHook) *Instrumented {
+	return &Instrumented{r: r, hook: hook, last: r.w}
+}
+
+// Stats returns the current counters.
+func (in *Instrumented) Stats() DrawStats {
+	return in.stats
+}
+
+func (in *Instrumented) before(method string, bound uint64) {
+	if in.hook != nil {
+		in.hook.OnDraw(method, bound)
+	}
+	in.stats.ForeignWords += in.consumed()
+}
+
+func (in *Instrumented) after() {
+	in.stats.Draws++
+	in.stats.Words += in.consumed()
+}
+
+// consumed returns the number of words generated since the last call.
+func (in *Instrumented) consumed() uint64 {
+	// SFC64 counter w is incremented once per generated word; reseeding resets it,
+	// in which case the words consumed before the reseed are unknown and not counted
+	n := uint64(0)
+	if in.r.w >= in.last {
+		n = in.r.w - in.last
+	}
+	in.last = in.r.w
+	return n
+}
+
+// ExpFloat64 calls [Rand.ExpFloat64] and accounts for the draw.
+func (in *Instrumented) ExpFloat64() float64 {
+	in.before("ExpFloat64", 0)
+	defer in.after()
+	return in.r.ExpFloat64()
+}
+
+// Float64 calls [Rand.Float64] and accounts for the draw.
+func (in *Instrumented) Float64() float64 {
+	in.before("Float64", 0)
+	defer in.after()
+	return in.r.Float64()
+}
+
+// Int calls [Rand.Int] and accounts for the draw.
+func (in *Instrumented) Int() int {
+	in.before("Int", 0)
+	defer in.after()
+	return in.r.Int()
+}
+
+// Int63 calls [Rand.Int63] and accounts for the draw.
+func (in *Instrumented) Int63() int64 {
+	in.before("Int63", 0)
+	defer in.after()
+	return in.r.Int63()
+}
+
+// Int63n calls [Rand.Int63n] and accounts for the draw.
+func (in *Instrumented) Int63n(n int64) int64 {
+	in.before("Int63n", uint64(n))
+	defer in.after()
+	return in.r.Int63n(n)
+}
+
+// Intn calls [Rand.Intn] and accounts for the draw.
+func (in *Instrumented) Intn(n int) int {
+	in.before("Intn", uint64(n))
+	defer in.after()
+	return in.r.Intn(n)
+}
+
+// NormFloat64 calls [Rand.NormFloat64] and accounts for the draw.
+func (in *Instrumented) NormFloat64() float64 {
+	in.before("NormFloat64", 0)
+	defer in.after()
+	return in.r.NormFloat64()
+}
+
+// Uint32 calls [Rand.Uint32] and accounts for the draw.
+func (in *Instrumented) Uint32() uint32 {
+	in.before("Uint32", 0)
+	defer in.after()
+	return in.r.Uint32()
+}
+
+// Uint32n calls [Rand.Uint32n] and accounts for the draw.
+func (in *Instrumented) Uint32n(n uint32) uint32 {
+	in.before("Uint32n", uint64(n))
+	defer in.after()
+	return in.r.Uint32n(n)
+}
+
+// Uint64 calls [Rand.Uint64] and accounts for the draw.
+func (in *Instrumented) Uint64() uint64 {
+	in.before("Uint64", 0)
+	defer in.after()
+	return in.r.Uint64()
+}
+
+// Uint64n calls [Rand.Uint64n] and accounts for the draw.
+func (in *Instrumented) Uint64n(n uint64) uint64 {
+	in.before("Uint64n", n)
+	defer in.after()
+	return in.r.Uint64n(n)
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+type countingHook map[string]int
+
+func (h countingHook) OnDraw(method string, _ uint64) {
+	h[method]++
+}
+
+func TestInstrumented(t *testing.T) {
+	r := rand.New(1)
+	hook := countingHook{}
+	in := rand.NewInstrumented(r, hook)
+	var d rand.Drawer = in
+	d.Uint64()
+	d.Float64()
+	d.Intn(10)
+	if st := in.Stats(); st.Draws != 3 || st.Words != 3 || st.ForeignWords != 0 {
+		t.Fatalf("got unexpected stats %+v", st)
+	}
+	if hook["Uint64"] != 1 || hook["Float64"] != 1 || hook["Intn"] != 1 {
+		t.Fatalf("got unexpected hook calls %v", hook)
+	}
+	r.Uint64()
+	r.Uint64()
+	d.Uint64n(1 << 40)
+	if st := in.Stats(); st.Draws != 4 || st.Words != 5 || st.ForeignWords != 2 {
+		t.Fatalf("got unexpected stats %+v after sharing", st)
+	}
+}
+
+func TestInstrumented_Reseed(t *testing.T) {
+	r := rand.New(1)
+	in := rand.NewInstrumented(r, nil)
+	for i := 0; i < 10; i++ {
+		in.Uint64()
+	}
+	r.Seed(2)
+	in.Uint64()
+	r.Seed(3)
+	r.Uint64()
+	in.Uint64()
+	if st := in.Stats(); st.Draws != 12 || st.Words != 12 || st.ForeignWords > 1 {
+		t.Fatalf("got unexpected stats %+v after reseeding", st)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+// Interleave returns a uniformly random merge of seqs: a slice containing the elements of all sequences,
+// where the elements of each sequence appear in their original order. Each of the possible merges
+// is equally likely.
+//
+// When r is nil, Interleave uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func Interleave[T any](r *Rand, seqs ...[]T) []T {
+	total := 0
+	for _, s := range seqs {
+		total += len(s)
+	}
+	pos := make([]int, len(seqs))
+	res := make([]T, 0, total)
+	// taking the next element of a sequence with probability proportional to the number
+	// of its remaining elements makes every merge equally likely
+	for left := total; left > 0; left-- {
+		u := randIntn(r, left)
+		for i, s := range seqs {
+			if rem := len(s) - pos[i]; u >= rem {
+				u -= rem
+				continue
+			}
+			res = append(res, s[pos[i]])
+			pos[i]++
+			break
+		}
+	}
+	return res
+}
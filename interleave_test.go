@@ -0,0 +1,49 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestInterleave_Order(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lens := rapid.SliceOfN(rapid.IntRange(0, 10), 0, 5).Draw(t, "lens").([]int)
+		seqs := make([][][2]int, len(lens))
+		total := 0
+		for i, n := range lens {
+			for j := 0; j < n; j++ {
+				seqs[i] = append(seqs[i], [2]int{i, j})
+			}
+			total += n
+		}
+		res := rand.Interleave(rand.New(s), seqs...)
+		if len(res) != total {
+			t.Fatalf("got %v elements instead of %v", len(res), total)
+		}
+		next := make([]int, len(seqs))
+		for _, e := range res {
+			if e[1] != next[e[0]] {
+				t.Fatalf("element %v of sequence %v is out of order in %v", e[1], e[0], res)
+			}
+			next[e[0]]++
+		}
+	})
+}
+
+func TestInterleave_Uniform(t *testing.T) {
+	r := rand.New(1)
+	// 5!/(2!*2!*1!) = 30 merges
+	checkUniformOutcomes(t, 30, func() string {
+		return string(rand.Interleave(r, []byte("ab"), []byte("cd"), []byte("e")))
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package stats implements the statistical tests shared by [github.com/gozelle/rand.SelfTest]
+// and [github.com/gozelle/rand/randtest]. All tests return p-values.
+package stats
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	gammaEps    = 1e-15
+	gammaTiny   = 1e-300
+	gammaMaxItr = 1000
+)
+
+// ChiSquared performs Pearson's chi-squared test of observed counts against expected ones,
+// with len(observed)-1 degrees of freedom. It returns the χ² statistic and the p-value.
+func ChiSquared(observed []int, expected []float64) (chi2 float64, p float64) {
+	for i, o := range observed {
+		d := float64(o) - expected[i]
+		chi2 += d * d / expected[i]
+	}
+	return chi2, GammaQ(float64(len(observed)-1)/2, chi2/2)
+}
+
+// Monobit performs the frequency (monobit) test of NIST SP 800-22 on the bits of data.
+func Monobit(data []byte) (p float64) {
+	n := float64(len(data) * 8)
+	s := 2*float64(OnesCount(data)) - n
+	return math.Erfc(math.Abs(s) / math.Sqrt(n) / math.Sqrt2)
+}
+
+// Runs performs the runs test of NIST SP 800-22 on the bits of data, most significant bit
+// of every byte first, without the prerequisite frequency check.
+func Runs(data []byte) (p float64) {
+	n := float64(len(data) * 8)
+	pi := float64(OnesCount(data)) / n
+	v := 1
+	for i, b := range data {
+		v += bits.OnesCount8((b ^ b>>1) & 0x7f) // transitions inside of a byte
+		if i > 0 && data[i-1]&1 != b>>7 {
+			v++
+		}
+	}
+	return math.Erfc(math.Abs(float64(v)-2*n*pi*(1-pi)) / (2 * math.Sqrt(2*n) * pi * (1 - pi)))
+}
+
+// OnesCount returns the number of one bits in data.
+func OnesCount(data []byte) int {
+	ones := 0
+	for _, b := range data {
+		ones += bits.OnesCount8(b)
+	}
+	return ones
+}
+
+// GammaQ returns the regularized upper incomplete gamma function Q(a, x).
+func GammaQ(a float64, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	lg, _ := math.Lgamma(a)
+	norm := math.Exp(-x + a*math.Log(x) - lg)
+	if x < a+1 {
+		// series representation of P(a, x)
+		sum := 1 / a
+		del := sum
+		for ap := a + 1; ap < a+gammaMaxItr; ap++ {
+			del *= x / ap
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*gammaEps {
+				break
+			}
+		}
+		return 1 - sum*norm
+	}
+	// continued fraction representation of Q(a, x), using modified Lentz's method
+	b := x + 1 - a
+	c := 1 / gammaTiny
+	d := 1 / b
+	h := d
+	for i := 1.0; i < gammaMaxItr; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < gammaTiny {
+			d = gammaTiny
+		}
+		c = b + an/c
+		if math.Abs(c) < gammaTiny {
+			c = gammaTiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < gammaEps {
+			break
+		}
+	}
+	return h * norm
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+import "sort"
+
+// ordered is the same as cmp.Ordered, which requires Go 1.21.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Keys returns the keys of m in uniformly random order. Unlike Go's map iteration order,
+// the order depends only on the keys and the state of r, which makes it reproducible from a seed:
+// Keys sorts the keys before shuffling them. For keys that are not ordered, use [KeysFunc].
+//
+// When r is nil, Keys uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func Keys[K ordered, V any](r *Rand, m map[K]V) []K {
+	return KeysFunc(r, m, func(a, b K) bool { return a < b })
+}
+
+// KeysFunc is like [Keys], but sorts the keys with less before shuffling them.
+// The order is reproducible from a seed only if less defines a strict total order on the keys.
+//
+// When r is nil, KeysFunc uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func KeysFunc[K comparable, V any](r *Rand, m map[K]V, less func(a, b K) bool) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	ShuffleSlice(r, keys)
+	return keys
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys[K ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		m := rapid.MapOf(rapid.String(), rapid.Int()).Draw(t, "m").(map[string]int)
+		keys := rand.Keys(rand.New(s), m)
+		if len(keys) != len(m) {
+			t.Fatalf("got %v keys instead of %v", len(keys), len(m))
+		}
+		seen := map[string]bool{}
+		for _, k := range keys {
+			if _, ok := m[k]; !ok || seen[k] {
+				t.Fatalf("key %q is missing from the map or repeated", k)
+			}
+			seen[k] = true
+		}
+		// a copy of the map has a different iteration order, but must produce the same keys
+		m2 := map[string]int{}
+		for k, v := range m {
+			m2[k] = v
+		}
+		keys2 := rand.Keys(rand.New(s), m2)
+		for i := range keys {
+			if keys[i] != keys2[i] {
+				t.Fatalf("got %q and %q at %v from the same seed", keys[i], keys2[i], i)
+			}
+		}
+	})
+}
+
+func TestKeys_Uniform(t *testing.T) {
+	r := rand.New(1)
+	m := map[string]bool{"a": true, "b": true, "c": true, "d": true}
+	checkUniformOutcomes(t, 24, func() string {
+		var s string
+		for _, k := range rand.Keys(r, m) {
+			s += k
+		}
+		return s
+	})
+}
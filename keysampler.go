@@ -0,0 +1,37 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// A KeySampler makes sampling decisions that depend only on a key, e.g. a trace or user ID:
+// every service using a KeySampler with the same seed and rate makes the same decision for a key,
+// in every run and in every future version of the package. Decisions are consistent across rates,
+// too: a key sampled at some rate is also sampled at every higher rate.
+// KeySampler is immutable and safe for concurrent use.
+type KeySampler struct {
+	seed uint64
+	rate float64
+}
+
+// NewKeySampler returns a KeySampler which samples a fraction rate of keys.
+// It panics if rate is not in [0, 1].
+func NewKeySampler(seed uint64, rate float64) *KeySampler {
+	if !(rate >= 0 && rate <= 1) {
+		panic("invalid argument to NewKeySampler")
+	}
+	return &KeySampler{seed: seed, rate: rate}
+}
+
+// Sample reports whether key is sampled.
+func (s *KeySampler) Sample(key string) bool {
+	return s.Value(key) < s.rate
+}
+
+// Value returns the stable value in the half-open interval [0.0, 1.0) that key is mapped to;
+// key is sampled when its value is less than the rate. Values of different keys are uniformly distributed.
+func (s *KeySampler) Value(key string) float64 {
+	return keyFloat64(keyHash(s.seed, key))
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"strconv"
+	"testing"
+)
+
+func TestKeySampler_Golden(t *testing.T) {
+	s := rand.NewKeySampler(1, 0.5)
+	golden := map[string]float64{"": 0.06347789697081574, "a": 0.15406484595354486, "trace-123": 0.2978596081831689}
+	for key, want := range golden {
+		if got := s.Value(key); got != want {
+			t.Errorf("Value(%q): got %v instead of %v", key, got, want)
+		}
+	}
+}
+
+func TestKeySampler_Sample(t *testing.T) {
+	for _, p := range []float64{0, 0.01, 0.3, 0.5, 1} {
+		s := rand.NewKeySampler(1, p)
+		checkRate(t, strconv.FormatFloat(p, 'g', -1, 64), 10000, p, func(i int) bool { return s.Sample(strconv.Itoa(i)) })
+	}
+}
+
+func TestKeySampler_Consistent(t *testing.T) {
+	s1 := rand.NewKeySampler(1, 0.3)
+	s2 := rand.NewKeySampler(1, 0.3)
+	s3 := rand.NewKeySampler(2, 0.3)
+	higher := rand.NewKeySampler(1, 0.6)
+	differ := false
+	for i := 0; i < 1000; i++ {
+		key := strconv.Itoa(i)
+		if s1.Sample(key) != s2.Sample(key) {
+			t.Fatalf("decision for %q differs between samplers with the same seed", key)
+		}
+		if s1.Sample(key) && !higher.Sample(key) {
+			t.Fatalf("%q sampled at rate 0.3 but not at rate 0.6", key)
+		}
+		differ = differ || s1.Sample(key) != s3.Sample(key)
+	}
+	if !differ {
+		t.Error("decisions are the same for samplers with different seeds")
+	}
+}
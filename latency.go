@@ -0,0 +1,101 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math"
+	"time"
+)
+
+// z-score of the 99th percentile of the standard normal distribution
+const normalP99 = 2.3263478740408408
+
+type latencyKind int
+
+const (
+	latencyLogNormal latencyKind = iota
+	latencyExponential
+	latencyEmpirical
+)
+
+// A Latency generates simulated latencies of operations, e.g. for chaos testing and load generation.
+// Latency is immutable and safe for concurrent use, as long as each goroutine uses its own [Rand].
+type Latency struct {
+	kind      latencyKind
+	mu, sigma float64 // lognormal: parameters of the logarithm of nanoseconds; exponential: mean in mu
+	emp       *Empirical
+	tailProb  float64
+	tailMin   time.Duration
+	tailMax   time.Duration
+}
+
+// NewLogNormalLatency returns a Latency with a lognormal distribution, the usual model of service latencies,
+// specified by its median and 99th percentile. It panics unless 0 < median <= p99.
+func NewLogNormalLatency(median time.Duration, p99 time.Duration) *Latency {
+	if !(median > 0 && median <= p99) {
+		panic("invalid argument to NewLogNormalLatency")
+	}
+	mu := math.Log(float64(median))
+	return &Latency{kind: latencyLogNormal, mu: mu, sigma: (math.Log(float64(p99)) - mu) / normalP99}
+}
+
+// NewExponentialLatency returns a Latency with an exponential distribution with the given mean.
+// It panics if mean <= 0.
+func NewExponentialLatency(mean time.Duration) *Latency {
+	if mean <= 0 {
+		panic("invalid argument to NewExponentialLatency")
+	}
+	return &Latency{kind: latencyExponential, mu: float64(mean)}
+}
+
+// NewEmpiricalLatency returns a Latency which resamples observed latencies, interpolating between them
+// (see [NewEmpirical]). NewEmpiricalLatency copies the samples. It panics if samples are empty or negative.
+func NewEmpiricalLatency(samples []time.Duration) *Latency {
+	if len(samples) == 0 {
+		panic("invalid argument to NewEmpiricalLatency")
+	}
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		if s < 0 {
+			panic("invalid argument to NewEmpiricalLatency")
+		}
+		values[i] = float64(s)
+	}
+	return &Latency{kind: latencyEmpirical, emp: NewEmpirical(values, true)}
+}
+
+// WithTail returns a copy of l which, with probability prob, replaces the latency with one
+// uniformly distributed in [min, max), simulating timeouts, GC pauses or retries.
+// It panics if prob is not in [0, 1] or max < min.
+func (l *Latency) WithTail(prob float64, min time.Duration, max time.Duration) *Latency {
+	if !(prob >= 0 && prob <= 1) || max < min {
+		panic("invalid argument to WithTail")
+	}
+	c := *l
+	c.tailProb, c.tailMin, c.tailMax = prob, min, max
+	return &c
+}
+
+// Duration returns a latency drawn from the distribution, using r as the source.
+func (l *Latency) Duration(r *Rand) time.Duration {
+	if l.tailProb > 0 && r.Float64() < l.tailProb {
+		return r.DurationBetween(l.tailMin, l.tailMax)
+	}
+	var ns float64
+	switch l.kind {
+	case latencyLogNormal:
+		ns = math.Exp(l.mu + l.sigma*r.NormFloat64())
+	case latencyExponential:
+		ns = l.mu * r.ExpFloat64()
+	default:
+		ns = l.emp.Float64(r)
+	}
+	if ns >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(ns)
+}
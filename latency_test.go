@@ -0,0 +1,68 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"sort"
+	"testing"
+	"time"
+)
+
+func latencyQuantiles(l *rand.Latency, qs ...float64) []time.Duration {
+	r := rand.New(1)
+	samples := make([]time.Duration, 100000)
+	for i := range samples {
+		samples[i] = l.Duration(r)
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	res := make([]time.Duration, len(qs))
+	for i, q := range qs {
+		res[i] = samples[int(q*float64(len(samples)))]
+	}
+	return res
+}
+
+func checkClose(t *testing.T, name string, got time.Duration, want time.Duration, tol float64) {
+	t.Helper()
+	if math.Abs(float64(got-want)) > tol*float64(want) {
+		t.Errorf("%v: got %v instead of %v", name, got, want)
+	}
+}
+
+func TestLogNormalLatency(t *testing.T) {
+	q := latencyQuantiles(rand.NewLogNormalLatency(10*time.Millisecond, 100*time.Millisecond), 0.5, 0.99)
+	checkClose(t, "median", q[0], 10*time.Millisecond, 0.03)
+	checkClose(t, "p99", q[1], 100*time.Millisecond, 0.1)
+}
+
+func TestExponentialLatency(t *testing.T) {
+	q := latencyQuantiles(rand.NewExponentialLatency(time.Second), 0.5)
+	checkClose(t, "median", q[0], 693147181*time.Nanosecond, 0.03) // ln(2) seconds
+}
+
+func TestEmpiricalLatency(t *testing.T) {
+	l := rand.NewEmpiricalLatency([]time.Duration{time.Millisecond, 3 * time.Millisecond})
+	q := latencyQuantiles(l, 0, 0.5)
+	if q[0] < time.Millisecond {
+		t.Errorf("got %v below the smallest sample", q[0])
+	}
+	checkClose(t, "median", q[1], 2*time.Millisecond, 0.03)
+}
+
+func TestLatency_WithTail(t *testing.T) {
+	base := rand.NewExponentialLatency(time.Millisecond)
+	l := base.WithTail(0.05, 10*time.Second, 20*time.Second)
+	q := latencyQuantiles(l, 0.94, 0.96)
+	if q[0] >= 10*time.Second || q[1] < 10*time.Second || q[1] >= 20*time.Second {
+		t.Errorf("got p94 = %v and p96 = %v with 5%% tail in [10s, 20s)", q[0], q[1])
+	}
+	if q := latencyQuantiles(base, 0.96); q[0] >= time.Second {
+		t.Errorf("WithTail modified the original Latency")
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+const (
+	macMulticastBit = 0x01 // I/G bit of the first octet
+	macLocalBit     = 0x02 // U/L bit of the first octet
+)
+
+// MAC returns a pseudo-random 48-bit hardware (MAC) address. The locally administered
+// (U/L) bit of the first octet is set when local is true and cleared otherwise, and the
+// group (I/G) bit is cleared when unicast is true and set otherwise; the other 46 bits are random.
+//
+// For addresses of virtual devices, which must not clash with vendor-assigned ones,
+// use MAC(true, true).
+func (r *Rand) MAC(local bool, unicast bool) (mac [6]byte) {
+	v := r.next64()
+	for i := range mac {
+		mac[i] = byte(v >> (8 * i))
+	}
+	mac[0] &^= macMulticastBit | macLocalBit
+	if local {
+		mac[0] |= macLocalBit
+	}
+	if !unicast {
+		mac[0] |= macMulticastBit
+	}
+	return mac
+}
@@ -0,0 +1,29 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_MAC(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		local := rapid.Bool().Draw(t, "local").(bool)
+		unicast := rapid.Bool().Draw(t, "unicast").(bool)
+		r := rand.New(s)
+		mac := r.MAC(local, unicast)
+		if got := mac[0]&0x02 != 0; got != local {
+			t.Fatalf("got locally administered bit %v instead of %v in %x", got, local, mac)
+		}
+		if got := mac[0]&0x01 == 0; got != unicast {
+			t.Fatalf("got unicast %v instead of %v in %x", got, unicast, mac)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// A Markov generates sequences of states of a discrete-time Markov chain with states [0, n).
+// States without outgoing transitions are terminal.
+// Markov is immutable and safe for concurrent use, as long as each goroutine uses its own [Rand].
+type Markov struct {
+	rows []alias // zero value for terminal states
+}
+
+// NewMarkov returns a Markov for the n×n transition matrix, with transitions[i][j] being
+// the weight of transition from state i to state j. Weights need not be normalized;
+// a row of zeroes denotes a terminal state. NewMarkov panics if the matrix is not square,
+// or contains negative, NaN or infinite weights.
+func NewMarkov(transitions [][]float64) *Markov {
+	m := &Markov{rows: make([]alias, len(transitions))}
+	for i, row := range transitions {
+		if len(row) != len(transitions) {
+			panic("invalid argument to NewMarkov")
+		}
+		if isZero(row) {
+			continue
+		}
+		a, ok := newAlias(row)
+		if !ok {
+			panic("invalid argument to NewMarkov")
+		}
+		m.rows[i] = a
+	}
+	return m
+}
+
+// LearnMarkov returns a Markov with n states and transition weights equal to the number
+// of times each transition occurs in the sequence. The last state of the sequence is terminal unless
+// the sequence leaves it earlier. LearnMarkov panics if any of the states is outside of [0, n).
+func LearnMarkov(sequence []int, n int) *Markov {
+	counts := make([][]float64, n)
+	for i := range counts {
+		counts[i] = make([]float64, n)
+	}
+	for i, s := range sequence {
+		if s < 0 || s >= n {
+			panic("invalid argument to LearnMarkov")
+		}
+		if i > 0 {
+			counts[sequence[i-1]][s]++
+		}
+	}
+	return NewMarkov(counts)
+}
+
+func isZero(s []float64) bool {
+	for _, v := range s {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// N returns the number of states.
+func (m *Markov) N() int {
+	return len(m.rows)
+}
+
+// Next returns the state following the state, using r as the source, or -1 if the state is terminal.
+// It panics if the state is outside of [0, n).
+func (m *Markov) Next(r *Rand, state int) int {
+	if state < 0 || state >= len(m.rows) {
+		panic("invalid argument to Next")
+	}
+	row := &m.rows[state]
+	if row.prob == nil {
+		return -1
+	}
+	return row.next(r)
+}
+
+// Sequence returns up to n states following the start, using r as the source.
+// The sequence is shorter than n only when it ends in a terminal state.
+// It panics if n < 0 or start is not a valid state.
+func (m *Markov) Sequence(r *Rand, start int, n int) []int {
+	if n < 0 {
+		panic("invalid argument to Sequence")
+	}
+	seq := make([]int, 0, n)
+	for s := start; len(seq) < n; {
+		s = m.Next(r, s)
+		if s < 0 {
+			break
+		}
+		seq = append(seq, s)
+	}
+	return seq
+}
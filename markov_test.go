@@ -0,0 +1,71 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestMarkov_Learned(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, 8).Draw(t, "n").(int)
+		seq := rapid.SliceOfN(rapid.IntRange(0, n-1), 1, tiny).Draw(t, "seq").([]int)
+		r := rand.New(s)
+		m := rand.LearnMarkov(seq, n)
+		seen := map[[2]int]bool{}
+		for i := 1; i < len(seq); i++ {
+			seen[[2]int{seq[i-1], seq[i]}] = true
+		}
+		prev := seq[0]
+		for _, cur := range m.Sequence(r, prev, small) {
+			if !seen[[2]int{prev, cur}] {
+				t.Fatalf("got unobserved transition %v -> %v", prev, cur)
+			}
+			prev = cur
+		}
+	})
+}
+
+func TestMarkov_Transitions(t *testing.T) {
+	r := rand.New(1)
+	m := rand.NewMarkov([][]float64{
+		{0, 1, 3},
+		{1, 0, 0},
+		{0, 0, 0},
+	})
+	if s := m.Next(r, 2); s != -1 {
+		t.Fatalf("got %v from terminal state", s)
+	}
+	if s := m.Next(r, 1); s != 0 {
+		t.Fatalf("got %v instead of 0", s)
+	}
+	const n = 10000
+	counts := make([]int, 3)
+	for i := 0; i < n; i++ {
+		counts[m.Next(r, 0)]++
+	}
+	if counts[0] != 0 {
+		t.Fatalf("got %v impossible transitions", counts[0])
+	}
+	if chi2, p := randtest.ChiSquared(counts[1:], []float64{n / 4, n * 3 / 4}); p < 1e-4 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+}
+
+func BenchmarkMarkov_Next(b *testing.B) {
+	var s int
+	r := rand.New(1)
+	m := rand.NewMarkov([][]float64{{1, 2}, {3, 4}})
+	for i := 0; i < b.N; i++ {
+		s = m.Next(r, s)
+	}
+	sinkInt = s
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// Mask returns a mask of n independent pseudo-random bits, each set with probability p, packed into
+// (n+63)/64 words: bit i is (mask[i/64] >> (i%64)) & 1, and bits past n are zero. Instead of drawing a value
+// per bit, Mask skips over runs of equal bits, so that sparse and dense masks take time proportional
+// to the number of rare bits. Mask panics if n < 0 or p is not in [0, 1].
+func (r *Rand) Mask(n int, p float64) []uint64 {
+	if n < 0 || !(p >= 0 && p <= 1) {
+		panic("invalid argument to Mask")
+	}
+	mask := make([]uint64, (n+63)/64)
+	switch {
+	case p == 0:
+		return mask
+	case p == 0.5:
+		for i := range mask {
+			mask[i] = r.next64()
+		}
+	case p == 1:
+		for i := range mask {
+			mask[i] = math.MaxUint64
+		}
+	default:
+		q := p
+		if p > 0.5 {
+			q = 1 - p // set the zero bits instead
+		}
+		// the gap between rare bits is geometrically distributed
+		logQ := math.Log1p(-q)
+		for i := -1; ; {
+			gap := math.Floor(math.Log(1-r.Float64()) / logQ)
+			if gap >= float64(n-i-1) {
+				break
+			}
+			i += int(gap) + 1
+			mask[i/64] |= 1 << (i % 64)
+		}
+		if p > 0.5 {
+			for i := range mask {
+				mask[i] = ^mask[i]
+			}
+		}
+	}
+	if n%64 != 0 {
+		mask[len(mask)-1] &= 1<<(n%64) - 1
+	}
+	return mask
+}
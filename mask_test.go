@@ -0,0 +1,88 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"math/bits"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Mask(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		p := rapid.OneOf(rapid.Just(0.0), rapid.Just(0.5), rapid.Just(1.0), rapid.Float64Range(0, 1)).Draw(t, "p").(float64)
+		mask := rand.New(s).Mask(n, p)
+		if len(mask) != (n+63)/64 {
+			t.Fatalf("got %v words for %v bits", len(mask), n)
+		}
+		if n%64 != 0 && mask[len(mask)-1]>>(n%64) != 0 {
+			t.Fatalf("got bits set past %v: %#x", n, mask[len(mask)-1])
+		}
+		ones := 0
+		for _, w := range mask {
+			ones += bits.OnesCount64(w)
+		}
+		if p == 0 && ones != 0 || p == 1 && ones != n {
+			t.Fatalf("got %v of %v bits set with p = %v", ones, n, p)
+		}
+	})
+}
+
+func TestRand_MaskProbability(t *testing.T) {
+	for _, p := range []float64{0.001, 0.1, 0.3, 0.5, 0.7, 0.99} {
+		const n = 100000
+		r := rand.New(1)
+		mask := r.Mask(n, p)
+		ones := 0
+		for _, w := range mask {
+			ones += bits.OnesCount64(w)
+		}
+		if z := (float64(ones) - n*p) / math.Sqrt(n*p*(1-p)); math.Abs(z) > 5 {
+			t.Errorf("got %v of %v bits set with p = %v", ones, n, p)
+		}
+		// runs of zeros between set bits must be geometric
+		observed := make([]int, 4)
+		gap := 0
+		for i := 0; i < n; i++ {
+			if mask[i/64]>>(i%64)&1 == 0 {
+				gap++
+				continue
+			}
+			if gap > 3 {
+				gap = 3
+			}
+			observed[gap]++
+			gap = 0
+		}
+		total := 0
+		for _, c := range observed {
+			total += c
+		}
+		expected := make([]float64, 4)
+		for k := 0; k < 3; k++ {
+			expected[k] = float64(total) * math.Pow(1-p, float64(k)) * p
+		}
+		expected[3] = float64(total) * math.Pow(1-p, 3)
+		if p > 0.01 && p < 0.9 {
+			if _, pv := randtest.ChiSquared(observed, expected); pv < 1e-4 {
+				t.Errorf("p = %v: got p-value %v for gaps %v", p, pv, observed)
+			}
+		}
+	}
+}
+
+func BenchmarkRand_Mask(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		_ = r.Mask(1<<16, 0.01)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// Accept implements the Metropolis acceptance criterion: it reports whether a move changing
+// the energy by deltaE should be accepted at the given temperature. Moves with deltaE <= 0 are always
+// accepted without drawing a value; other moves are accepted with probability exp(-deltaE/temperature),
+// which is zero at zero temperature. Accept panics if deltaE is NaN or temperature is negative or NaN.
+func (r *Rand) Accept(deltaE float64, temperature float64) bool {
+	if math.IsNaN(deltaE) || !(temperature >= 0) {
+		panic("invalid argument to Accept")
+	}
+	if deltaE <= 0 {
+		return true
+	}
+	if temperature == 0 {
+		return false
+	}
+	return r.Float64() < math.Exp(-deltaE/temperature)
+}
+
+// A MetropolisHastings generates Markov chains with a given stationary distribution,
+// using the Metropolis–Hastings algorithm. MetropolisHastings is immutable and safe for concurrent use,
+// as long as each goroutine uses its own [Rand] and the functions it was created with are safe for concurrent use.
+type MetropolisHastings struct {
+	logDensity func(x []float64) float64
+	propose    func(r *Rand, dst []float64, x []float64) float64
+	burnIn     int
+	thin       int
+}
+
+// NewMetropolisHastings returns a MetropolisHastings for the target distribution with the given
+// unnormalized log-density, which can return -Inf outside of the support.
+//
+// propose must write a proposed move from x into dst (of the same length), using r as the source,
+// and return log(q(x | dst)) - log(q(dst | x)), where q is the proposal density;
+// the return value is zero for symmetric proposals, like the ones made by [NormalProposal].
+//
+// Chains drop the first burnIn states and keep every thin-th state after them.
+// NewMetropolisHastings panics if logDensity or propose is nil, burnIn < 0 or thin < 1.
+func NewMetropolisHastings(logDensity func(x []float64) float64, propose func(r *Rand, dst []float64, x []float64) float64, burnIn int, thin int) *MetropolisHastings {
+	if logDensity == nil || propose == nil || burnIn < 0 || thin < 1 {
+		panic("invalid argument to NewMetropolisHastings")
+	}
+	return &MetropolisHastings{logDensity: logDensity, propose: propose, burnIn: burnIn, thin: thin}
+}
+
+// Chain runs a chain starting at x0 and returns n of its states, along with the fraction
+// of proposed moves that were accepted. The chain is fully determined by the state of r.
+// Chain panics if n < 0 or the log-density of x0 is NaN.
+func (mh *MetropolisHastings) Chain(r *Rand, x0 []float64, n int) (states [][]float64, acceptance float64) {
+	lp := mh.logDensity(x0)
+	if n < 0 || math.IsNaN(lp) {
+		panic("invalid argument to Chain")
+	}
+	x := append([]float64(nil), x0...)
+	y := make([]float64, len(x0))
+	states = make([][]float64, 0, n)
+	steps, accepted := 0, 0
+	for ; len(states) < n; steps++ {
+		logQ := mh.propose(r, y, x)
+		lpy := mh.logDensity(y)
+		if math.Log(r.Float64()) < lpy-lp+logQ {
+			x, y = y, x
+			lp = lpy
+			accepted++
+		}
+		if steps >= mh.burnIn && (steps-mh.burnIn)%mh.thin == mh.thin-1 {
+			states = append(states, append([]float64(nil), x...))
+		}
+	}
+	if steps > 0 {
+		acceptance = float64(accepted) / float64(steps)
+	}
+	return states, acceptance
+}
+
+// NormalProposal returns a symmetric random walk proposal for [NewMetropolisHastings],
+// which moves every coordinate by an independent normal step with standard deviation scale.
+// NormalProposal panics if scale is not positive and finite.
+func NormalProposal(scale float64) func(r *Rand, dst []float64, x []float64) float64 {
+	if !(scale > 0) || math.IsInf(scale, 1) {
+		panic("invalid argument to NormalProposal")
+	}
+	return func(r *Rand, dst []float64, x []float64) float64 {
+		for i, v := range x {
+			dst[i] = v + scale*r.NormFloat64()
+		}
+		return 0
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Accept(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		d := rapid.Float64().Draw(t, "d").(float64)
+		temp := rapid.Float64Min(0).Draw(t, "temp").(float64)
+		r := rand.New(s)
+		state := r.State()
+		ok := r.Accept(d, temp)
+		if d <= 0 && (!ok || r.State() != state) {
+			t.Fatalf("downhill move %v rejected or consumed randomness", d)
+		}
+		if d > 0 && temp == 0 && ok {
+			t.Fatalf("uphill move %v accepted at zero temperature", d)
+		}
+	})
+}
+
+func TestRand_AcceptProbability(t *testing.T) {
+	const n = 100000
+	r := rand.New(1)
+	accepted := 0
+	for i := 0; i < n; i++ {
+		if r.Accept(1, 2) {
+			accepted++
+		}
+	}
+	p := math.Exp(-0.5)
+	if z := (float64(accepted) - n*p) / math.Sqrt(n*p*(1-p)); math.Abs(z) > 5 {
+		t.Fatalf("accepted %v of %v moves, expected %.0f", accepted, n, n*p)
+	}
+}
+
+func TestMetropolisHastings_Normal(t *testing.T) {
+	logDensity := func(x []float64) float64 { return -(x[0] - 3) * (x[0] - 3) / 8 } // N(3, 2²)
+	mh := rand.NewMetropolisHastings(logDensity, rand.NormalProposal(3), 1000, 5)
+	states, acceptance := mh.Chain(rand.New(1), []float64{0}, 20000)
+	if len(states) != 20000 {
+		t.Fatalf("got %v states instead of %v", len(states), 20000)
+	}
+	if acceptance <= 0 || acceptance >= 1 {
+		t.Fatalf("got acceptance rate %v", acceptance)
+	}
+	var mean, sq float64
+	for _, x := range states {
+		mean += x[0]
+		sq += x[0] * x[0]
+	}
+	mean /= float64(len(states))
+	variance := sq/float64(len(states)) - mean*mean
+	if math.Abs(mean-3) > 0.1 || math.Abs(variance-4) > 0.3 {
+		t.Fatalf("got mean %v and variance %v instead of 3 and 4", mean, variance)
+	}
+}
+
+func TestMetropolisHastings_Reproducible(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		burnIn := rapid.IntRange(0, 10).Draw(t, "burnIn").(int)
+		thin := rapid.IntRange(1, 5).Draw(t, "thin").(int)
+		n := rapid.IntRange(0, 20).Draw(t, "n").(int)
+		logDensity := func(x []float64) float64 { return -x[0]*x[0]/2 - math.Abs(x[1]) }
+		mh := rand.NewMetropolisHastings(logDensity, rand.NormalProposal(1), burnIn, thin)
+		a, _ := mh.Chain(rand.New(s), []float64{0, 0}, n)
+		b, _ := mh.Chain(rand.New(s), []float64{0, 0}, n)
+		if len(a) != n {
+			t.Fatalf("got %v states instead of %v", len(a), n)
+		}
+		for i := range a {
+			if a[i][0] != b[i][0] || a[i][1] != b[i][1] {
+				t.Fatalf("chains diverged at state %v: %v vs %v", i, a[i], b[i])
+			}
+		}
+	})
+}
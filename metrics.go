@@ -0,0 +1,53 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"expvar"
+	"sync/atomic"
+)
+
+var (
+	metricsEnabled  uint32 // set by Metrics
+	globalReads     uint64
+	globalBytesRead uint64
+)
+
+// Metrics returns an expvar-compatible variable reporting the entropy consumption
+// of the package-level functions as a JSON object:
+//
+//   - "reads": number of [Read] calls, including the ones made through [Reader]
+//   - "bytes_read": total number of bytes produced by these calls
+//
+// Individual values drawn by the other package-level functions are not counted:
+// they are lock-free and must stay this way; wrap a [Rand] with [Instrumented] to
+// account for them. The global generator is never seeded, so there are no reseeds to report.
+//
+// Counting is opt-in and starts with the first call to Metrics: the counters are shared
+// between all goroutines, and updating them would make concurrent [Read] calls contend
+// for the same cache line. Once enabled, counting stays enabled.
+//
+// Metrics does not publish the variable; to do so, call
+//
+//	expvar.Publish("rand", rand.Metrics())
+func Metrics() expvar.Var {
+	atomic.StoreUint32(&metricsEnabled, 1)
+	return expvar.Func(func() interface{} {
+		return map[string]uint64{
+			"reads":      atomic.LoadUint64(&globalReads),
+			"bytes_read": atomic.LoadUint64(&globalBytesRead),
+		}
+	})
+}
+
+func countRead(n int) {
+	if atomic.LoadUint32(&metricsEnabled) == 0 {
+		return
+	}
+	atomic.AddUint64(&globalReads, 1)
+	atomic.AddUint64(&globalBytesRead, uint64(n))
+}
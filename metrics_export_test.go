@@ -0,0 +1,19 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "sync/atomic"
+
+func SetMetricsEnabledForTest(enabled bool) (prev bool) {
+	prev = atomic.LoadUint32(&metricsEnabled) != 0
+	if enabled {
+		atomic.StoreUint32(&metricsEnabled, 1)
+	} else {
+		atomic.StoreUint32(&metricsEnabled, 0)
+	}
+	return prev
+}
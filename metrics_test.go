@@ -0,0 +1,54 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+func readMetrics(t *testing.T) map[string]uint64 {
+	t.Helper()
+	var m map[string]uint64
+	if err := json.Unmarshal([]byte(rand.Metrics().String()), &m); err != nil {
+		t.Fatalf("failed to decode metrics: %v", err)
+	}
+	return m
+}
+
+func TestMetrics(t *testing.T) {
+	before := readMetrics(t)
+	buf := make([]byte, 13)
+	_, _ = rand.Read(buf)
+	_, _ = rand.Reader.Read(buf[:5])
+	after := readMetrics(t)
+	// other tests may read concurrently, so only lower bounds can be checked
+	if d := after["reads"] - before["reads"]; d < 2 {
+		t.Errorf("got %v reads, want at least 2", d)
+	}
+	if d := after["bytes_read"] - before["bytes_read"]; d < 18 {
+		t.Errorf("got %v bytes read, want at least 18", d)
+	}
+}
+
+func BenchmarkRead_Metrics(b *testing.B) {
+	for _, enabled := range []bool{false, true} {
+		b.Run(fmt.Sprintf("enabled=%v", enabled), func(b *testing.B) {
+			prev := rand.SetMetricsEnabledForTest(enabled)
+			defer rand.SetMetricsEnabledForTest(prev)
+			b.RunParallel(func(pb *testing.PB) {
+				var p [8]byte
+				b.SetBytes(int64(len(p)))
+				for pb.Next() {
+					_, _ = rand.Read(p[:])
+				}
+			})
+		})
+	}
+}
@@ -4,6 +4,13 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
+// Practrand writes raw generator output to stdout, for piping into
+// statistical test suites like PractRand, dieharder or TestU01:
+//
+//	practrand -seed 1 | RNG_test stdin64
+//	practrand -seed 1 | dieharder -a -g 200
+//
+// By default, the seed is random and the output is endless.
 package main
 
 import (
@@ -14,6 +21,7 @@ import (
 	"github.com/valyala/fastrand"
 	exprand "golang.org/x/exp/rand"
 	"hash/maphash"
+	"io"
 	"log"
 	"math"
 	"math/bits"
@@ -27,7 +35,6 @@ const (
 	numChunks      = 1024
 	bufSizeBits    = numChunks * chunkSizeBits
 	bufSizeBytes   = bufSizeBits / 8
-	bufSizeWords   = bufSizeBytes / 8
 	maxInt52       = 1<<52 - 1
 )
 
@@ -160,11 +167,30 @@ func setBit(buf []byte, i int, b bool) {
 	}
 }
 
-func run(gen string, transform string, shuffle string) error {
+// config holds the command-line parameters of run.
+type config struct {
+	gen       string
+	transform string
+	shuffle   string
+	seed      uint64
+	stream    uint64
+	hasStream bool
+	n         int64 // number of bytes to write, endless if negative
+}
+
+func run(w io.Writer, c config) error {
+	if c.hasStream && c.gen != "rand" {
+		return fmt.Errorf("RNG %q does not support streams", c.gen)
+	}
 	var ctor func(uint64) randGen
-	switch gen {
+	switch c.gen {
 	case "rand":
-		ctor = func(s uint64) randGen { return rand.New(s) }
+		ctor = func(s uint64) randGen {
+			if c.hasStream {
+				return rand.New(s, c.stream)
+			}
+			return rand.New(s)
+		}
 	case "std":
 		ctor = func(s uint64) randGen { return mathrand.New(mathrand.NewSource(int64(s))) }
 	case "x":
@@ -180,13 +206,13 @@ func run(gen string, transform string, shuffle string) error {
 			return exprand.New(&fastSource{rng})
 		}
 	default:
-		return fmt.Errorf("unknown RNG: %q", gen)
+		return fmt.Errorf("unknown RNG: %q", c.gen)
 	}
 	
-	s := new(maphash.Hash).Sum64()
+	s := c.seed
 	rng := func(s uint64) *rand64 { return &rand64{ctor(s)} }
 	var g func() uint64
-	switch transform {
+	switch c.transform {
 	case "none":
 		g = rng(s).raw
 	case "f64":
@@ -208,34 +234,38 @@ func run(gen string, transform string, shuffle string) error {
 			return u
 		}
 	default:
-		return fmt.Errorf("unknown transform: %q", transform)
+		return fmt.Errorf("unknown transform: %q", c.transform)
 	}
 	
-	buf := make([]byte, 8*bufSizeWords)
-	switch shuffle {
+	size := int64(bufSizeBytes)
+	if c.n >= 0 && c.n < size {
+		size = (c.n + chunkSizeBytes - 1) / chunkSizeBytes * chunkSizeBytes // whole chunks for shuffling
+	}
+	buf := make([]byte, size)
+	switch c.shuffle {
 	case "none":
-		return output(buf, g, nil)
+		return output(w, buf, c.n, g, nil)
 	case "mod":
-		return output(buf, g, uint16nModulo)
+		return output(w, buf, c.n, g, uint16nModulo)
 	case "fp":
-		return output(buf, g, uint16nFixedPoint)
+		return output(w, buf, c.n, g, uint16nFixedPoint)
 	case "lfp":
-		return output(buf, g, uint16nLongFixedPoint)
+		return output(w, buf, c.n, g, uint16nLongFixedPoint)
 	case "lemire":
-		return output(buf, g, uint16nLemire)
+		return output(w, buf, c.n, g, uint16nLemire)
 	default:
-		return fmt.Errorf("unknown shuffle method: %q", shuffle)
+		return fmt.Errorf("unknown shuffle method: %q", c.shuffle)
 	}
 }
 
-func output(buf []byte, g func() uint64, b func(func() uint64, uint16) uint16) error {
-	for {
+func output(w io.Writer, buf []byte, n int64, g func() uint64, b func(func() uint64, uint16) uint16) error {
+	for n != 0 {
 		if b == nil {
-			for i := 0; i < bufSizeWords; i++ {
+			for i := 0; i < len(buf)/8; i++ {
 				binary.LittleEndian.PutUint64(buf[i*8:], g())
 			}
 		} else {
-			for i := 0; i < numChunks; i++ {
+			for i := 0; i < len(buf)/chunkSizeBytes; i++ {
 				ch := buf[i*chunkSizeBytes : (i+1)*chunkSizeBytes]
 				for j := 0; j < len(ch); j++ {
 					if j < len(ch)/2 {
@@ -248,11 +278,19 @@ func output(buf []byte, g func() uint64, b func(func() uint64, uint16) uint16) e
 			}
 		}
 		
-		_, err := os.Stdout.Write(buf)
+		chunk := buf
+		if n > 0 && n < int64(len(chunk)) {
+			chunk = chunk[:n]
+		}
+		_, err := w.Write(chunk)
 		if err != nil {
 			return err
 		}
+		if n > 0 {
+			n -= int64(len(chunk))
+		}
 	}
+	return nil
 }
 
 func main() {
@@ -260,10 +298,28 @@ func main() {
 		gen       = flag.String("gen", "rand", "RNG to use (rand/std/x/x-wy/x-fast)")
 		transform = flag.String("transform", "none", "transform to use (none/f64/norm/rand/8seed)")
 		shuffle   = flag.String("shuffle", "none", "shuffle algorithm to use (none/mod/fp/lfp/lemire)")
+		seed      = flag.Uint64("seed", 0, "generator seed (random if not set; ignored by x-rand-g and 8seed)")
+		stream    = flag.Uint64("stream", 0, "stream to use, seeding generator with both seed and stream (rand only)")
+		n         = flag.Int64("n", -1, "number of bytes to write (endless if negative)")
 	)
 	flag.Parse()
 	
-	err := run(*gen, *transform, *shuffle)
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if !set["seed"] {
+		*seed = new(maphash.Hash).Sum64()
+		log.Printf("using seed %v", *seed)
+	}
+	
+	err := run(os.Stdout, config{
+		gen:       *gen,
+		transform: *transform,
+		shuffle:   *shuffle,
+		seed:      *seed,
+		stream:    *stream,
+		hasStream: set["stream"],
+		n:         *n,
+	})
 	if err != nil {
 		log.Fatal(err.Error())
 	}
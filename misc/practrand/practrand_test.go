@@ -0,0 +1,68 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+func TestRun_Raw(t *testing.T) {
+	for _, c := range []struct {
+		cfg  config
+		want *rand.Rand
+	}{
+		{config{gen: "rand", transform: "none", shuffle: "none", seed: 1, n: 20}, rand.New(1)},
+		{config{gen: "rand", transform: "none", shuffle: "none", seed: 1, stream: 2, hasStream: true, n: 20}, rand.New(1, 2)},
+	} {
+		var buf bytes.Buffer
+		if err := run(&buf, c.cfg); err != nil {
+			t.Fatalf("%+v: got unexpected error: %v", c.cfg, err)
+		}
+		want := make([]byte, 24)
+		for i := 0; i < len(want); i += 8 {
+			binary.LittleEndian.PutUint64(want[i:], c.want.Uint64())
+		}
+		if !bytes.Equal(buf.Bytes(), want[:20]) {
+			t.Errorf("%+v: got %x instead of %x", c.cfg, buf.Bytes(), want[:20])
+		}
+	}
+}
+
+func TestRun_Deterministic(t *testing.T) {
+	for _, gen := range []string{"rand", "std", "x", "x-wy", "x-fast"} {
+		for _, shuffle := range []string{"none", "lemire"} {
+			cfg := config{gen: gen, transform: "f64", shuffle: shuffle, seed: 3, n: 1000}
+			var a, b bytes.Buffer
+			if err := run(&a, cfg); err != nil {
+				t.Fatalf("%+v: got unexpected error: %v", cfg, err)
+			}
+			if err := run(&b, cfg); err != nil {
+				t.Fatalf("%+v: got unexpected error: %v", cfg, err)
+			}
+			if a.Len() != 1000 || !bytes.Equal(a.Bytes(), b.Bytes()) {
+				t.Errorf("%+v: got %v bytes, different between runs with the same seed", cfg, a.Len())
+			}
+		}
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	for _, cfg := range []config{
+		{gen: "foo", transform: "none", shuffle: "none"},
+		{gen: "rand", transform: "foo", shuffle: "none"},
+		{gen: "rand", transform: "none", shuffle: "foo"},
+		{gen: "std", transform: "none", shuffle: "none", hasStream: true},
+	} {
+		var buf bytes.Buffer
+		if err := run(&buf, cfg); err == nil {
+			t.Errorf("%+v: got no error", cfg)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !randcheck
+
+package rand
+
+// misuse detection is enabled with the randcheck build tag, see misuse_randcheck.go
+
+func checkSeed(seed ...uint64) {}
+
+func checkToken() {}
@@ -0,0 +1,120 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build randcheck
+
+package rand
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// When built with the randcheck build tag, the package reports patterns that usually indicate misuse:
+//   - many generators seeded with the same value, which typically means a constant seed
+//     in code that expects independent generators;
+//   - generation of tokens with Text and TextN, which must not be used for secrets.
+//
+// Every problem is reported once, with the location of the offending call, using the standard logger.
+// When the RANDCHECK environment variable is set to "panic", the package panics instead.
+
+const (
+	misuseSameSeedLimit = 16
+	misuseMaxSeeds      = 1 << 16 // bounds the memory used to track seeds
+)
+
+// seedKey identifies a seed; New(s) and New(s, 0) produce different generators and have different keys.
+type seedKey struct {
+	n int
+	s [3]uint64
+}
+
+var misuse struct {
+	mu       sync.Mutex
+	seeds    map[seedKey]int
+	reported map[string]bool
+}
+
+func reportMisuse(format string, args ...interface{}) {
+	msg := fmt.Sprintf("rand: "+format+" at "+callerLocation(), args...)
+	misuse.mu.Lock()
+	if misuse.reported == nil {
+		misuse.reported = map[string]bool{}
+	}
+	dup := misuse.reported[msg]
+	misuse.reported[msg] = true
+	misuse.mu.Unlock()
+	if dup {
+		return
+	}
+	if os.Getenv("RANDCHECK") == "panic" {
+		panic(msg)
+	}
+	log.Print(msg)
+}
+
+// callerLocation returns the location of the innermost call from outside of the package,
+// skipping the package functions that call each other, like NewWorker calling New.
+func callerLocation() string {
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs[:])])
+	pkg := ""
+	for {
+		f, more := frames.Next()
+		if pkg == "" {
+			pkg = funcPackage(f.Function) // callerLocation itself
+		} else if funcPackage(f.Function) != pkg {
+			return fmt.Sprintf("%v:%v", f.File, f.Line)
+		}
+		if !more {
+			return "unknown location"
+		}
+	}
+}
+
+// funcPackage returns the import path of the package of the fully qualified function name.
+func funcPackage(name string) string {
+	slash := strings.LastIndexByte(name, '/')
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot >= 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
+
+func checkSeed(seed ...uint64) {
+	if len(seed) == 0 {
+		return
+	}
+	key := seedKey{n: len(seed)}
+	copy(key.s[:], seed)
+	misuse.mu.Lock()
+	n, ok := misuse.seeds[key]
+	if !ok && len(misuse.seeds) >= misuseMaxSeeds {
+		misuse.seeds = nil // forget the old seeds instead of growing without bound
+	}
+	if misuse.seeds == nil {
+		misuse.seeds = map[seedKey]int{}
+	}
+	report := false
+	if n < misuseSameSeedLimit {
+		// stop counting once the seed is reported
+		n++
+		misuse.seeds[key] = n
+		report = n == misuseSameSeedLimit
+	}
+	misuse.mu.Unlock()
+	if report {
+		reportMisuse("%v generators seeded with the same value %v; use distinct seeds or SeedSequence for independent generators", n, seed)
+	}
+}
+
+func checkToken() {
+	reportMisuse("Text or TextN called; their output must not be used for secrets, use crypto/rand.Text instead")
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build randcheck
+
+package rand_test
+
+import (
+	"bytes"
+	"github.com/gozelle/rand"
+	"log"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMisuse(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	for i := 0; i < 100; i++ {
+		rand.New(0xdeadbeef)
+	}
+	for i := 0; i < 3; i++ {
+		_ = rand.New(1).Text()
+	}
+	out := buf.String()
+	if strings.Count(out, "seeded with the same value") != 1 || strings.Count(out, "Text or TextN called") != 1 {
+		t.Fatalf("got unexpected misuse reports:\n%s", out)
+	}
+	if !strings.Contains(out, "misuse_randcheck_test.go") {
+		t.Fatalf("reports do not point to the offending calls:\n%s", out)
+	}
+}
+
+func TestMisuse_NewWorker(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	for i := 0; i < 100; i++ {
+		rand.NewWorker(0xfeed, 7)
+	}
+	out := buf.String()
+	if strings.Count(out, "seeded with the same value") != 1 || !strings.Contains(out, "misuse_randcheck_test.go") {
+		t.Fatalf("report does not point to the NewWorker call:\n%s", out)
+	}
+}
+
+func TestMisuse_SeedLength(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	for i := 0; i < 10; i++ {
+		rand.New(0xc0ffee)
+		rand.New(0xc0ffee, 0)
+	}
+	if out := buf.String(); strings.Contains(out, "seeded with the same value") {
+		t.Fatalf("New(s) and New(s, 0) counted as the same seed:\n%s", out)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+import (
+	"encoding/binary"
+	"net/netip"
+)
+
+// IPv4 returns a uniformly distributed pseudo-random IPv4 address.
+func (r *Rand) IPv4() netip.Addr {
+	var a [4]byte
+	binary.BigEndian.PutUint32(a[:], uint32(r.next64()))
+	return netip.AddrFrom4(a)
+}
+
+// IPv6 returns a uniformly distributed pseudo-random IPv6 address.
+func (r *Rand) IPv6() netip.Addr {
+	var a [16]byte
+	binary.BigEndian.PutUint64(a[0:], r.next64())
+	binary.BigEndian.PutUint64(a[8:], r.next64())
+	return netip.AddrFrom16(a)
+}
+
+// AddrFromPrefix returns a uniformly distributed pseudo-random address from p.
+// AddrFromPrefix panics if p is not valid.
+func (r *Rand) AddrFromPrefix(p netip.Prefix) netip.Addr {
+	if !p.IsValid() {
+		panic("invalid argument to AddrFromPrefix")
+	}
+	return r.addrFromPrefix(p, false)
+}
+
+// HostFromPrefix returns a uniformly distributed pseudo-random address from p,
+// excluding the network address (all host bits zero) and the broadcast address
+// (all host bits one) when p has at least 2 host bits.
+// HostFromPrefix panics if p is not valid.
+func (r *Rand) HostFromPrefix(p netip.Prefix) netip.Addr {
+	if !p.IsValid() {
+		panic("invalid argument to HostFromPrefix")
+	}
+	return r.addrFromPrefix(p, true)
+}
+
+func (r *Rand) addrFromPrefix(p netip.Prefix, hostsOnly bool) netip.Addr {
+	p = p.Masked()
+	if p.Addr().Is4() {
+		hostBits := 32 - p.Bits()
+		mask := uint32(uint64(1)<<hostBits - 1)
+		a := p.Addr().As4()
+		net := binary.BigEndian.Uint32(a[:])
+		for {
+			host := uint32(r.next64()) & mask
+			if hostsOnly && hostBits >= 2 && (host == 0 || host == mask) {
+				continue
+			}
+			binary.BigEndian.PutUint32(a[:], net|host)
+			return netip.AddrFrom4(a)
+		}
+	}
+	hostBits := 128 - p.Bits()
+	var maskHi, maskLo uint64
+	switch {
+	case hostBits >= 64:
+		maskHi, maskLo = 1<<(hostBits-64)-1, 1<<64-1
+	default:
+		maskLo = 1<<hostBits - 1
+	}
+	a := p.Addr().As16()
+	netHi, netLo := binary.BigEndian.Uint64(a[0:]), binary.BigEndian.Uint64(a[8:])
+	for {
+		hi, lo := r.next64()&maskHi, r.next64()&maskLo
+		if hostsOnly && hostBits >= 2 && ((hi == 0 && lo == 0) || (hi == maskHi && lo == maskLo)) {
+			continue
+		}
+		binary.BigEndian.PutUint64(a[0:], netHi|hi)
+		binary.BigEndian.PutUint64(a[8:], netLo|lo)
+		return netip.AddrFrom16(a)
+	}
+}
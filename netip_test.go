@@ -0,0 +1,58 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"net/netip"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_IPv4(t *testing.T) {
+	r := rand.New(1)
+	if a := r.IPv4(); !a.Is4() {
+		t.Fatalf("got %v instead of IPv4 address", a)
+	}
+	if a := r.IPv6(); !a.Is6() {
+		t.Fatalf("got %v instead of IPv6 address", a)
+	}
+}
+
+func TestRand_AddrFromPrefix(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		hostsOnly := rapid.Bool().Draw(t, "hostsOnly").(bool)
+		r := rand.New(s)
+		var p netip.Prefix
+		if rapid.Bool().Draw(t, "v4").(bool) {
+			p = netip.PrefixFrom(r.IPv4(), rapid.IntRange(0, 32).Draw(t, "bits").(int))
+		} else {
+			p = netip.PrefixFrom(r.IPv6(), rapid.IntRange(0, 128).Draw(t, "bits").(int))
+		}
+		var a netip.Addr
+		if hostsOnly {
+			a = r.HostFromPrefix(p)
+		} else {
+			a = r.AddrFromPrefix(p)
+		}
+		if !p.Contains(a) {
+			t.Fatalf("got %v outside of %v", a, p)
+		}
+		if hostsOnly && a.BitLen()-p.Bits() >= 2 {
+			if a == p.Masked().Addr() {
+				t.Fatalf("got network address %v of %v", a, p)
+			}
+			next := a.Next()
+			if !next.IsValid() || !p.Contains(next) {
+				t.Fatalf("got broadcast address %v of %v", a, p)
+			}
+		}
+	})
+}
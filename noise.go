@@ -0,0 +1,124 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// A Noise generates deterministic gradient (Perlin) noise: a smooth pseudo-random function
+// of 1, 2 or 3 coordinates, equal to zero at the integer lattice points and varying at unit scale.
+// Noise is immutable and safe for concurrent use.
+type Noise struct {
+	perm [512]uint8
+}
+
+// NewNoise returns a Noise with the permutation table generated by r.
+func NewNoise(r *Rand) *Noise {
+	n := &Noise{}
+	for i, v := range r.Perm(256) {
+		n.perm[i] = uint8(v)
+		n.perm[i+256] = uint8(v)
+	}
+	return n
+}
+
+// Noise1 returns one-dimensional noise at x, in the interval [-1, 1].
+func (n *Noise) Noise1(x float64) float64 {
+	fx := math.Floor(x)
+	ix := int(int64(fx) & 255)
+	x -= fx
+	u := fade(x)
+	return lerp(u, grad1(n.perm[ix], x), grad1(n.perm[ix+1], x-1))
+}
+
+// Noise2 returns two-dimensional noise at (x, y), approximately in the interval [-1, 1].
+func (n *Noise) Noise2(x float64, y float64) float64 {
+	fx, fy := math.Floor(x), math.Floor(y)
+	ix, iy := int(int64(fx)&255), int(int64(fy)&255)
+	x, y = x-fx, y-fy
+	u, v := fade(x), fade(y)
+	a, b := int(n.perm[ix])+iy, int(n.perm[ix+1])+iy
+	return lerp(v,
+		lerp(u, grad2(n.perm[a], x, y), grad2(n.perm[b], x-1, y)),
+		lerp(u, grad2(n.perm[a+1], x, y-1), grad2(n.perm[b+1], x-1, y-1)))
+}
+
+// Noise3 returns three-dimensional noise at (x, y, z), approximately in the interval [-1, 1].
+func (n *Noise) Noise3(x float64, y float64, z float64) float64 {
+	// "Improving Noise" by Ken Perlin, https://mrl.cs.nyu.edu/~perlin/paper445.pdf
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	ix, iy, iz := int(int64(fx)&255), int(int64(fy)&255), int(int64(fz)&255)
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+	a := int(n.perm[ix]) + iy
+	aa, ab := int(n.perm[a])+iz, int(n.perm[a+1])+iz
+	b := int(n.perm[ix+1]) + iy
+	ba, bb := int(n.perm[b])+iz, int(n.perm[b+1])+iz
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad3(n.perm[aa], x, y, z), grad3(n.perm[ba], x-1, y, z)),
+			lerp(u, grad3(n.perm[ab], x, y-1, z), grad3(n.perm[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, grad3(n.perm[aa+1], x, y, z-1), grad3(n.perm[ba+1], x-1, y, z-1)),
+			lerp(u, grad3(n.perm[ab+1], x, y-1, z-1), grad3(n.perm[bb+1], x-1, y-1, z-1))))
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(t float64, a float64, b float64) float64 {
+	return a + t*(b-a)
+}
+
+func grad1(h uint8, x float64) float64 {
+	// 16 gradients evenly spaced in [-1, 1], excluding 0
+	g := (float64(h&15) + 0.5) / 8
+	return (g - 1) * x * 2
+}
+
+func grad2(h uint8, x float64, y float64) float64 {
+	switch h & 7 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x
+	case 5:
+		return -x
+	case 6:
+		return y
+	default:
+		return -y
+	}
+}
+
+func grad3(h uint8, x float64, y float64, z float64) float64 {
+	// 12 edge directions of a cube, with 4 of them repeated
+	h &= 15
+	u := y
+	if h < 8 {
+		u = x
+	}
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestNoise_Lattice(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		x := float64(rapid.IntRange(-small, small).Draw(t, "x").(int))
+		y := float64(rapid.IntRange(-small, small).Draw(t, "y").(int))
+		z := float64(rapid.IntRange(-small, small).Draw(t, "z").(int))
+		n := rand.NewNoise(rand.New(s))
+		if v := n.Noise1(x); v != 0 {
+			t.Fatalf("got Noise1(%v) = %v", x, v)
+		}
+		if v := n.Noise2(x, y); v != 0 {
+			t.Fatalf("got Noise2(%v, %v) = %v", x, y, v)
+		}
+		if v := n.Noise3(x, y, z); v != 0 {
+			t.Fatalf("got Noise3(%v, %v, %v) = %v", x, y, z, v)
+		}
+	})
+}
+
+func TestNoise_Smooth(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		x := rapid.Float64Range(-small, small).Draw(t, "x").(float64)
+		y := rapid.Float64Range(-small, small).Draw(t, "y").(float64)
+		z := rapid.Float64Range(-small, small).Draw(t, "z").(float64)
+		n := rand.NewNoise(rand.New(s))
+		const eps = 1e-6
+		vs := [...][2]float64{
+			{n.Noise1(x), n.Noise1(x + eps)},
+			{n.Noise2(x, y), n.Noise2(x+eps, y-eps)},
+			{n.Noise3(x, y, z), n.Noise3(x+eps, y-eps, z+eps)},
+		}
+		for i, v := range vs {
+			// bound is loose enough to hold for both the range and the gradient magnitude
+			if math.Abs(v[0]) > 1.1 || math.Abs(v[1]-v[0]) > 10*eps {
+				t.Fatalf("got noise %v near (%v, %v, %v) in %v dimensions", v, x, y, z, i+1)
+			}
+		}
+	})
+}
+
+func TestNoise_Deterministic(t *testing.T) {
+	n1 := rand.NewNoise(rand.New(1))
+	n2 := rand.NewNoise(rand.New(1))
+	n3 := rand.NewNoise(rand.New(2))
+	if n1.Noise3(0.5, 1.5, 2.5) != n2.Noise3(0.5, 1.5, 2.5) {
+		t.Fatal("got different noise for the same seed")
+	}
+	if n1.Noise3(0.5, 1.5, 2.5) == n3.Noise3(0.5, 1.5, 2.5) && n1.Noise2(0.3, 0.7) == n3.Noise2(0.3, 0.7) {
+		t.Fatal("got same noise for different seeds")
+	}
+}
+
+func BenchmarkNoise_Noise3(b *testing.B) {
+	var s float64
+	n := rand.NewNoise(rand.New(1))
+	for i := 0; i < b.N; i++ {
+		s = n.Noise3(float64(i)*0.01, 0.5, 0.25)
+	}
+	sinkFloat64 = s
+}
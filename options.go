@@ -0,0 +1,61 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// An Option configures a generator created by [NewWith].
+type Option func(*options)
+
+type options struct {
+	seeded   bool
+	seed     uint64
+	streamed bool
+	stream   uint64
+}
+
+// WithSeed makes the generator deterministic, seeding it with seed.
+func WithSeed(seed uint64) Option {
+	return func(o *options) {
+		o.seeded, o.seed = true, seed
+	}
+}
+
+// WithStream selects one of the 2^64 streams of a deterministic generator:
+// generators with the same seed and different streams produce unrelated sequences.
+// It must be used together with [WithSeed].
+func WithStream(stream uint64) Option {
+	return func(o *options) {
+		o.streamed, o.stream = true, stream
+	}
+}
+
+// WithAutoSeed makes the generator non-deterministic, overriding an earlier [WithSeed]. It is the default.
+func WithAutoSeed() Option {
+	return func(o *options) {
+		o.seeded = false
+	}
+}
+
+// NewWith returns a generator configured by opts, applied in order. Without options,
+// it is the same as New(). NewWith(WithSeed(s)) is the same as New(s), and
+// NewWith(WithSeed(s), WithStream(t)) is the same as New(s, t).
+// NewWith panics if [WithStream] is used without [WithSeed].
+func NewWith(opts ...Option) *Rand {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	switch {
+	case o.seeded && o.streamed:
+		return New(o.seed, o.stream)
+	case o.seeded:
+		return New(o.seed)
+	case o.streamed:
+		panic("invalid argument to NewWith")
+	default:
+		return New()
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestNewWith(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		st := rapid.Uint64().Draw(t, "st").(uint64)
+		if a, b := rand.NewWith(rand.WithSeed(s)).Uint64(), rand.New(s).Uint64(); a != b {
+			t.Fatalf("got %v instead of %v for seed %v", a, b, s)
+		}
+		if a, b := rand.NewWith(rand.WithStream(st), rand.WithSeed(s)).Uint64(), rand.New(s, st).Uint64(); a != b {
+			t.Fatalf("got %v instead of %v for seed %v and stream %v", a, b, s, st)
+		}
+		if a, b := rand.NewWith(rand.WithSeed(s), rand.WithAutoSeed()).Uint64(), rand.New(s).Uint64(); a == b {
+			t.Fatalf("WithAutoSeed did not override WithSeed")
+		}
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "sync"
+
+// ReadParallel fills p with pseudo-random bytes using the specified number of goroutines.
+// p is split into workers contiguous parts of (almost) equal size, and each part is filled
+// by a child generator seeded from r. For a given state of r, the result depends
+// only on len(p) and workers, and not on the goroutine scheduling.
+// ReadParallel panics if workers <= 0.
+func (r *Rand) ReadParallel(p []byte, workers int) {
+	if workers <= 0 {
+		panic("invalid argument to ReadParallel")
+	}
+	children := make([]Rand, workers)
+	for i := range children {
+		children[i].init3(r.next64(), r.next64(), r.next64())
+	}
+	chunk := len(p) / workers
+	var wg sync.WaitGroup
+	wg.Add(workers - 1)
+	for i := 0; i < workers-1; i++ {
+		go func(c *Rand, q []byte) {
+			defer wg.Done()
+			_, _ = c.Read(q)
+		}(&children[i], p[i*chunk:(i+1)*chunk])
+	}
+	_, _ = children[workers-1].Read(p[(workers-1)*chunk:])
+	wg.Wait()
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"bytes"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_ReadParallel(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		workers := rapid.IntRange(1, 16).Draw(t, "workers").(int)
+		buf1 := make([]byte, n)
+		buf2 := make([]byte, n)
+		rand.New(s).ReadParallel(buf1, workers)
+		rand.New(s).ReadParallel(buf2, workers)
+		if !bytes.Equal(buf1, buf2) {
+			t.Fatalf("got %q and %q for the same seed", buf1, buf2)
+		}
+	})
+}
+
+func BenchmarkRand_ReadParallel(b *testing.B) {
+	r := rand.New(1)
+	p := make([]byte, 1<<24)
+	b.SetBytes(int64(len(p)))
+	for i := 0; i < b.N; i++ {
+		r.ReadParallel(p, 4)
+	}
+}
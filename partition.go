@@ -0,0 +1,81 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// SetPartition returns a partition of the integers in the half-open interval [0, n), uniformly
+// distributed over all B(n) (n-th Bell number) partitions, as a restricted growth string:
+// p[i] is the index of the block containing i, with blocks indexed in order of their smallest elements.
+// It panics if n < 0.
+func (r *Rand) SetPartition(n int) []int {
+	if n < 0 {
+		panic("invalid argument to SetPartition")
+	}
+	p := make([]int, n)
+	if n == 0 {
+		return p
+	}
+	// "Generation of a random partition of a finite set by an urn model" by A. J. Stam:
+	// throwing n balls into m urns, with m chosen with probability m^n/(m! e B(n)), leaves
+	// non-empty urns forming a uniformly distributed partition
+	weights := stamWeights(n)
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	u := r.Float64() * sum
+	m := len(weights)
+	for k, w := range weights {
+		if u < w {
+			m = k + 1
+			break
+		}
+		u -= w
+	}
+	block := make([]int, m)
+	for i := range block {
+		block[i] = -1
+	}
+	blocks := 0
+	for i := range p {
+		urn := r.Intn(m)
+		if block[urn] < 0 {
+			block[urn] = blocks
+			blocks++
+		}
+		p[i] = block[urn]
+	}
+	return p
+}
+
+// stamWeights returns weights proportional to m^n/m! for m = 1, 2, ..., until they become negligible.
+func stamWeights(n int) []float64 {
+	// terms peak at m0 with m0 ln m0 ≈ n, and are within 2^-64 of the peak for about 10 standard deviations
+	// of width sqrt(m0 / ln m0) around it; this sizes logs without reallocation, with a few spare elements
+	m0 := float64(n)
+	for i := 0; i < 8; i++ {
+		m0 = float64(n) / math.Log(m0+1)
+	}
+	logs := make([]float64, 0, int(m0+10*math.Sqrt(m0/math.Log(m0+2)))+24)
+	top := math.Inf(-1)
+	for m := 1; ; m++ {
+		lg, _ := math.Lgamma(float64(m + 1))
+		l := float64(n)*math.Log(float64(m)) - lg
+		logs = append(logs, l)
+		if l > top {
+			top = l
+		} else if l < top-64*math.Ln2 {
+			// past the maximum, terms decrease faster than geometrically
+			break
+		}
+	}
+	for i, l := range logs {
+		logs[i] = math.Exp(l - top)
+	}
+	return logs
+}
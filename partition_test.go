@@ -0,0 +1,48 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"fmt"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_SetPartition(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		p := r.SetPartition(n)
+		if len(p) != n {
+			t.Fatalf("got %v elements instead of %v", len(p), n)
+		}
+		blocks := 0
+		for _, b := range p {
+			if b < 0 || b > blocks {
+				t.Fatalf("got %v which is not a restricted growth string", p)
+			}
+			if b == blocks {
+				blocks++
+			}
+		}
+	})
+}
+
+func TestRand_SetPartitionUniform(t *testing.T) {
+	r := rand.New(1)
+	// there are B(5) = 52 partitions of 5 elements
+	checkUniformOutcomes(t, 52, func() string { return fmt.Sprint(r.SetPartition(5)) })
+}
+
+func BenchmarkRand_SetPartition(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		sinkInt = r.SetPartition(small)[small-1]
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math/bits"
+
+// PermEach calls fn count times, each time with a pseudo-random permutation of the integers in the half-open
+// interval [0, n). All calls share the same slice, which is valid only until fn returns. PermEach panics if n < 0 or count < 0.
+//
+// PermEach reuses one slice instead of allocating a new permutation per call, and extracts several bounded
+// random numbers from every 64-bit value generated. For n = 52 it runs about as fast as [Rand.Perm] and faster
+// than [Rand.Shuffle] with a swap function. It produces a different stream of permutations.
+func (r *Rand) PermEach(n int, count int, fn func(p []int)) {
+	if n < 0 || count < 0 {
+		panic("invalid argument to PermEach")
+	}
+	p := make([]int, n)
+	for k := 0; k < count; k++ {
+		for i := range p {
+			p[i] = i
+		}
+		r.shuffleBatched(p)
+		fn(p)
+	}
+}
+
+// shuffleBatched is a Fisher–Yates shuffle, generating as many indices from one 64-bit value
+// as possible while keeping the bias at the level of [Rand.Uint32n].
+func (r *Rand) shuffleBatched(p []int) {
+	// "Batched Ranged Random Integer Generation" by Nevin Brackett-Rozinsky and Daniel Lemire, https://arxiv.org/abs/2408.06213
+	i := len(p) - 1
+	for i > 0 {
+		if uint64(i) >= 1<<32 {
+			j := r.Uint64n(uint64(i) + 1)
+			p[i], p[j] = p[j], p[i]
+			i--
+			continue
+		}
+		// each index consumes log2(i+1) bits of x; bias is bounded by the product of bounds / 2^64
+		x := r.next64()
+		prod := uint64(1) // prod <= 2^32 and i+1 < 2^32, so multiplication does not overflow
+		for i > 0 && prod*(uint64(i)+1) <= 1<<32 {
+			hi, lo := bits.Mul64(x, uint64(i)+1)
+			p[i], p[hi] = p[hi], p[i]
+			x = lo
+			prod *= uint64(i) + 1
+			i--
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"fmt"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_PermEach(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		count := rapid.IntRange(0, 10).Draw(t, "count").(int)
+		r := rand.New(s)
+		calls := 0
+		r.PermEach(n, count, func(p []int) {
+			calls++
+			seen := make([]bool, n)
+			for _, v := range p {
+				if v < 0 || v >= n || seen[v] {
+					t.Fatalf("got %v which is not a permutation", p)
+				}
+				seen[v] = true
+			}
+		})
+		if calls != count {
+			t.Fatalf("got %v calls instead of %v", calls, count)
+		}
+	})
+}
+
+func TestRand_PermEachUniform(t *testing.T) {
+	r := rand.New(1)
+	for _, n := range []int{2, 3, 4, 5} {
+		want := 1
+		for i := 2; i <= n; i++ {
+			want *= i
+		}
+		checkUniformOutcomes(t, want, func() string {
+			var key string
+			r.PermEach(n, 1, func(p []int) { key = fmt.Sprint(p) })
+			return key
+		})
+	}
+}
+
+func BenchmarkRand_PermEach(b *testing.B) {
+	r := rand.New(1)
+	var s int
+	r.PermEach(tiny, b.N, func(p []int) { s += p[0] })
+	sinkInt = s
+}
+
+func BenchmarkRand_PermEachBaseline(b *testing.B) {
+	r := rand.New(1)
+	var s int
+	p := make([]int, tiny)
+	for i := 0; i < b.N; i++ {
+		for j := range p {
+			p[j] = j
+		}
+		r.Shuffle(len(p), func(i, j int) { p[i], p[j] = p[j], p[i] })
+		s += p[0]
+	}
+	sinkInt = s
+}
+
+func BenchmarkRand_PermEachPerm(b *testing.B) {
+	r := rand.New(1)
+	var s int
+	for i := 0; i < b.N; i++ {
+		s += r.Perm(tiny)[0]
+	}
+	sinkInt = s
+}
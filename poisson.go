@@ -0,0 +1,51 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// PoissonProcess returns, in increasing order, event times of a homogeneous Poisson process
+// with the given rate in the half-open interval [0, horizon).
+// It panics if rate or horizon are negative, infinite or NaN.
+func (r *Rand) PoissonProcess(rate float64, horizon float64) []float64 {
+	if !(rate >= 0) || math.IsInf(rate, 1) || !(horizon >= 0) || math.IsInf(horizon, 1) {
+		panic("invalid argument to PoissonProcess")
+	}
+	var events []float64
+	if rate == 0 {
+		return events
+	}
+	for t := r.ExpFloat64() / rate; t < horizon; t += r.ExpFloat64() / rate {
+		events = append(events, t)
+	}
+	return events
+}
+
+// PoissonProcessFunc returns, in increasing order, event times of a non-homogeneous Poisson process
+// with the rate function rate in the half-open interval [0, horizon), using thinning with
+// maxRate being the upper bound of rate on [0, horizon). It panics if maxRate or horizon are negative,
+// infinite or NaN, or if rate returns a value outside of [0, maxRate].
+func (r *Rand) PoissonProcessFunc(rate func(t float64) float64, maxRate float64, horizon float64) []float64 {
+	// "Simulation of nonhomogeneous Poisson processes by thinning" by P. A. W. Lewis and G. S. Shedler
+	if !(maxRate >= 0) || math.IsInf(maxRate, 1) || !(horizon >= 0) || math.IsInf(horizon, 1) {
+		panic("invalid argument to PoissonProcessFunc")
+	}
+	var events []float64
+	if maxRate == 0 {
+		return events
+	}
+	for t := r.ExpFloat64() / maxRate; t < horizon; t += r.ExpFloat64() / maxRate {
+		l := rate(t)
+		if !(l >= 0 && l <= maxRate) {
+			panic("invalid argument to PoissonProcessFunc")
+		}
+		if r.Float64()*maxRate < l {
+			events = append(events, t)
+		}
+	}
+	return events
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_PoissonProcess(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		rate := rapid.Float64Range(0, 100).Draw(t, "rate").(float64)
+		horizon := rapid.Float64Range(0, 10).Draw(t, "horizon").(float64)
+		r := rand.New(s)
+		prev := 0.0
+		for _, e := range r.PoissonProcess(rate, horizon) {
+			if e < prev || e >= horizon {
+				t.Fatalf("got event at %v after %v with horizon %v", e, prev, horizon)
+			}
+			prev = e
+		}
+	})
+}
+
+func TestRand_PoissonProcessUniform(t *testing.T) {
+	// conditional on their number, event times are uniformly distributed
+	r := rand.New(1)
+	events := r.PoissonProcess(1000, 10)
+	if n := float64(len(events)); math.Abs(n-10000) > 5*100 {
+		t.Errorf("got %v events instead of ~10000", n)
+	}
+	if err := randtest.CheckUniform(events, 0, 10, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRand_PoissonProcessFunc(t *testing.T) {
+	// with rate(t) = 2t, event times on [0, 1) have density 2t
+	r := rand.New(1)
+	events := r.PoissonProcessFunc(func(t float64) float64 { return 20000 * t }, 20000, 1)
+	if n := float64(len(events)); math.Abs(n-10000) > 5*100 {
+		t.Errorf("got %v events instead of ~10000", n)
+	}
+	if d, p := randtest.KolmogorovSmirnov(events, func(x float64) float64 { return x * x }); p < 1e-4 {
+		t.Errorf("got D = %v, p = %v", d, p)
+	}
+}
+
+func BenchmarkRand_PoissonProcess(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		sinkInt = len(r.PoissonProcess(small, 1))
+	}
+}
@@ -23,6 +23,7 @@ package rand
 
 import (
 	"encoding/binary"
+	"errors"
 	"io"
 	"math"
 	"math/bits"
@@ -38,9 +39,22 @@ const (
 	f24Mul = 0x1.0p-24
 	f53Mul = 0x1.0p-53
 
-	randSizeof = 8*4 + 8 + 1
+	randSizeof     = 8*4 + 8 + 1
+	randSizeofBits = randSizeof + 8 + 1
+
+	writeBufSize = 4096
 )
 
+// StateSize is the maximum length in bytes of the binary representation of the generator state,
+// as returned by [Rand.MarshalBinary] and [Rand.AppendBinary].
+const StateSize = randSizeofBits
+
+// StateWords is the number of words in the raw generator state,
+// as returned by [Rand.State].
+const StateWords = 8
+
+var errInvalidState = errors.New("rand: invalid generator state")
+
 // Rand is a pseudo-random number generator based on the [SFC64] algorithm by Chris Doty-Humphrey.
 //
 // SFC64 has 256 bits of state, average period of ~2^255 and minimum period of at least 2^64.
@@ -50,18 +64,47 @@ const (
 // [SFC64]: http://pracrand.sourceforge.net/RNG_engines.txt
 type Rand struct {
 	sfc64
-	val uint64
-	pos int
+	val    uint64
+	pos    int
+	bitVal uint64
+	bitPos int
 }
 
 // New returns an initialized generator. If seed is empty, generator is initialized to a non-deterministic state.
 // Otherwise, generator is seeded with the values from seed. New panics if len(seed) > 3.
 func New(seed ...uint64) *Rand {
+	checkSeed(seed...)
 	var r Rand
 	r.new_(seed...)
 	return &r
 }
 
+// NewV1 is like [New], but with a stronger compatibility promise. Currently, NewV1 and New return
+// identical generators. For generators returned by NewV1, the seeding algorithm and the output streams
+// of the methods covered by the golden regression test are frozen: ExpFloat64, Float32, Float64, Int,
+// Int31, Int31n, Int63, Int63n, Intn, MarshalBinary, NormFloat64, Perm, Read, Shuffle, Uint32, Uint32n,
+// Uint64 and Uint64n produce the same outputs in every future version of the package. Should New switch
+// to an improved algorithm for any of them, generators returned by NewV1 will keep the current one.
+// Outputs of the other methods are not frozen. Use NewV1 when outputs are persisted, e.g. in golden files.
+// NewV1 panics if len(seed) > 3.
+func NewV1(seed ...uint64) *Rand {
+	checkSeed(seed...)
+	var r Rand
+	r.new_(seed...)
+	return &r
+}
+
+// NewWorker returns a deterministically seeded generator for the worker with the given ID,
+// so that a pool of goroutines sharing masterSeed produces the same results in every run, no matter
+// how the goroutines are scheduled. It is the same as New(masterSeed, workerID).
+//
+// SFC64 has no jump-ahead function, so the streams of different workers are not guaranteed
+// to be disjoint; instead, they start at unrelated points of a cycle of average length 2^255,
+// which makes an overlap within a practical number of outputs vanishingly unlikely.
+func NewWorker(masterSeed uint64, workerID uint64) *Rand {
+	return New(masterSeed, workerID)
+}
+
 func (r *Rand) new_(seed ...uint64) {
 	switch len(seed) {
 	case 0:
@@ -79,25 +122,56 @@ func (r *Rand) new_(seed ...uint64) {
 
 // Seed uses the provided seed value to initialize the generator to a deterministic state.
 func (r *Rand) Seed(seed uint64) {
+	checkSeed(seed)
 	r.init1(seed)
 	r.val = 0
 	r.pos = 0
+	r.bitVal = 0
+	r.bitPos = 0
+}
+
+// Zero wipes the state of the generator, including any buffered output, by overwriting it with zeroes.
+// Until it is re-seeded, the generator produces a fixed, predictable sequence.
+func (r *Rand) Zero() {
+	*r = Rand{}
+}
+
+// Close calls [Rand.Zero] and returns nil. It allows the generator to be used as an [io.Closer],
+// to make sure its state does not outlive the code that uses it.
+func (r *Rand) Close() error {
+	r.Zero()
+	return nil
 }
 
 // MarshalBinary returns the binary representation of the current state of the generator.
 func (r *Rand) MarshalBinary() ([]byte, error) {
-	var data [randSizeof]byte
-	r.marshalBinary(&data)
-	return data[:], nil
+	var data [randSizeofBits]byte
+	n := r.marshalBinary(&data)
+	return data[:n], nil
+}
+
+// AppendBinary appends the binary representation of the current state of the generator to dst
+// and returns the extended buffer. It does not allocate when dst has room for [StateSize] more bytes.
+func (r *Rand) AppendBinary(dst []byte) ([]byte, error) {
+	var data [randSizeofBits]byte
+	n := r.marshalBinary(&data)
+	return append(dst, data[:n]...), nil
 }
 
-func (r *Rand) marshalBinary(data *[randSizeof]byte) {
+func (r *Rand) marshalBinary(data *[randSizeofBits]byte) int {
 	binary.LittleEndian.PutUint64(data[0:], r.a)
 	binary.LittleEndian.PutUint64(data[8:], r.b)
 	binary.LittleEndian.PutUint64(data[16:], r.c)
 	binary.LittleEndian.PutUint64(data[24:], r.w)
 	binary.LittleEndian.PutUint64(data[32:], r.val)
 	data[40] = byte(r.pos)
+	if r.bitPos == 0 {
+		// keep the representation unchanged for generators that have no buffered bits
+		return randSizeof
+	}
+	binary.LittleEndian.PutUint64(data[41:], r.bitVal)
+	data[49] = byte(r.bitPos)
+	return randSizeofBits
 }
 
 // UnmarshalBinary sets the state of the generator to the state represented in data.
@@ -111,6 +185,33 @@ func (r *Rand) UnmarshalBinary(data []byte) error {
 	r.w = binary.LittleEndian.Uint64(data[24:])
 	r.val = binary.LittleEndian.Uint64(data[32:])
 	r.pos = int(data[40])
+	r.bitVal, r.bitPos = 0, 0
+	if len(data) >= randSizeofBits {
+		if data[49] > 64 {
+			return errInvalidState
+		}
+		r.bitVal = binary.LittleEndian.Uint64(data[41:])
+		r.bitPos = int(data[49])
+	}
+	return nil
+}
+
+// State returns the raw state of the generator: 4 words of SFC64 state,
+// followed by the buffered output word and the number of bytes left in it,
+// followed by the word buffered by [Rand.Bits] and the number of bits left in it.
+func (r *Rand) State() (words [StateWords]uint64) {
+	return [StateWords]uint64{r.a, r.b, r.c, r.w, r.val, uint64(r.pos), r.bitVal, uint64(r.bitPos)}
+}
+
+// SetState sets the state of the generator to the raw state previously returned by [Rand.State].
+// It returns an error if words do not represent a valid state.
+func (r *Rand) SetState(words [StateWords]uint64) error {
+	if words[5] > 8 || words[7] > 64 {
+		return errInvalidState
+	}
+	r.a, r.b, r.c, r.w = words[0], words[1], words[2], words[3]
+	r.val, r.pos = words[4], int(words[5])
+	r.bitVal, r.bitPos = words[6], int(words[7])
 	return nil
 }
 
@@ -124,7 +225,34 @@ func (r *Rand) Float64() float64 {
 	return float64(r.next64()&int53Mask) * f53Mul
 }
 
+// Float64Full returns, as a float64, a pseudo-random number in the closed interval [0.0, 1.0],
+// obtained by choosing a real number uniformly from [0, 1] and rounding it to the nearest float64.
+// Unlike [Rand.Float64], which returns multiples of 2^-53, Float64Full can return
+// every float64 in the interval, giving full resolution to small values.
+func (r *Rand) Float64Full() float64 {
+	// "Uniform random floats" by Taylor R. Campbell, https://mumble.net/~campbell/2014/04/28/uniform-random-float
+	exp := -64
+	x := r.next64()
+	for x == 0 {
+		exp -= 64
+		if exp < -1074-64 {
+			return 0
+		}
+		x = r.next64()
+	}
+	if shift := bits.LeadingZeros64(x); shift != 0 {
+		exp -= shift
+		x = x<<shift | r.next64()>>(64-shift)
+	}
+	// set the sticky bit, since the infinite tail of the real number is almost surely non-zero
+	x |= 1
+	return math.Ldexp(float64(x), exp)
+}
+
 // Int returns a uniformly distributed non-negative pseudo-random int.
+// It consumes the same amount of generator output as [Rand.Int63] and returns
+// int(Int63() & math.MaxInt), so results on 32-bit platforms are truncated results of 64-bit ones.
+// For values identical across platforms, use [Rand.Int63].
 func (r *Rand) Int() int {
 	return int(r.next64() & intMask)
 }
@@ -159,6 +287,7 @@ func (r *Rand) Int63n(n int64) int64 {
 
 // Intn returns, as an int, a uniformly distributed non-negative pseudo-random number
 // in the half-open interval [0, n). It panics if n <= 0.
+// On all platforms, Intn(n) returns the same value as [Rand.Int63n](int64(n)).
 func (r *Rand) Intn(n int) int {
 	if n <= 0 {
 		panic("invalid argument to Intn")
@@ -170,7 +299,32 @@ func (r *Rand) Intn(n int) int {
 	}
 }
 
+// IntRange returns, as an int, a uniformly distributed pseudo-random number
+// in the half-open interval [lo, hi). It panics if lo >= hi.
+func (r *Rand) IntRange(lo, hi int) int {
+	if lo >= hi {
+		panic("invalid argument to IntRange")
+	}
+	// hi-lo computed in unsigned arithmetic never overflows, even for the full int span
+	if math.MaxInt == math.MaxInt32 {
+		return lo + int(r.Uint32n(uint32(hi)-uint32(lo)))
+	} else {
+		return lo + int(r.Uint64n(uint64(hi)-uint64(lo)))
+	}
+}
+
+// Int64Range returns, as an int64, a uniformly distributed pseudo-random number
+// in the half-open interval [lo, hi). It panics if lo >= hi.
+func (r *Rand) Int64Range(lo, hi int64) int64 {
+	if lo >= hi {
+		panic("invalid argument to Int64Range")
+	}
+	return lo + int64(r.Uint64n(uint64(hi)-uint64(lo)))
+}
+
 // Perm returns, as a slice of n ints, a pseudo-random permutation of the integers in the half-open interval [0, n).
+// Its output for a given seed is frozen (see [NewV1]), so it draws one index per element; to generate
+// many permutations without allocating, use [Rand.PermEach], which produces a different stream.
 func (r *Rand) Perm(n int) []int {
 	p := make([]int, n)
 	r.perm(p)
@@ -218,10 +372,35 @@ func (r *Rand) Read(p []byte) (n int, err error) {
 	return
 }
 
+// WriteRandom writes n pseudo-random bytes to w, generating them in chunks through an internal buffer.
+// The bytes written are the same as the ones a series of Read calls would produce.
+// It returns the number of bytes written and the first error encountered while writing, if any.
+func (r *Rand) WriteRandom(w io.Writer, n int64) (written int64, err error) {
+	var buf [writeBufSize]byte
+	for written < n {
+		p := buf[:]
+		if n-written < int64(len(p)) {
+			p = p[:n-written]
+		}
+		_, _ = r.Read(p)
+		m, err := w.Write(p)
+		written += int64(m)
+		if err != nil {
+			return written, err
+		}
+		if m != len(p) {
+			return written, io.ErrShortWrite
+		}
+	}
+	return written, nil
+}
+
 // Shuffle pseudo-randomizes the order of elements. n is the number of elements. Shuffle panics if n < 0.
 // swap swaps the elements with indexes i and j.
 //
 // For shuffling elements of a slice, prefer the top-level [ShuffleSlice] function.
+// Shuffle draws one index per swap: its output for a given seed is frozen (see [NewV1]),
+// so it cannot switch to the batched index generation used by [Rand.PermEach].
 func (r *Rand) Shuffle(n int, swap func(i, j int)) {
 	if n < 0 {
 		panic("invalid argument to Shuffle")
@@ -271,6 +450,15 @@ func (r *Rand) Uint64() uint64 {
 	return r.next64()
 }
 
+// Uint128 returns a uniformly distributed pseudo-random 128-bit value as a pair of uint64 halves.
+// hi is drawn first and is the same as the result of calling [Rand.Uint64]; lo is drawn second.
+// To get the value as 16 bytes, encode hi followed by lo with [binary.BigEndian].
+func (r *Rand) Uint128() (hi uint64, lo uint64) {
+	hi = r.next64()
+	lo = r.next64()
+	return hi, lo
+}
+
 // Uint64n returns, as an uint64, a uniformly distributed pseudo-random number in [0, n). Uint64n(0) returns 0.
 func (r *Rand) Uint64n(n uint64) uint64 {
 	// "An optimal algorithm for bounded random integers" by Stephen Canon, https://github.com/apple/swift/pull/39143
@@ -10,6 +10,7 @@ package rand_test
 
 import (
 	"github.com/gozelle/rand"
+	"io"
 	"math"
 	"testing"
 )
@@ -292,6 +293,14 @@ func BenchmarkRand_ExpFloat64(b *testing.B) {
 	sinkFloat64 = s
 }
 
+func BenchmarkRand_ExpFloat64s(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]float64, small)
+	for i := 0; i < b.N; i++ {
+		r.ExpFloat64s(dst)
+	}
+}
+
 func BenchmarkRand_Float32(b *testing.B) {
 	var s float32
 	r := rand.New(1)
@@ -310,6 +319,24 @@ func BenchmarkRand_Float64(b *testing.B) {
 	sinkFloat64 = s
 }
 
+func BenchmarkRand_Float32s(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]float32, small)
+	b.SetBytes(int64(len(dst)) * 4)
+	for i := 0; i < b.N; i++ {
+		r.Float32s(dst)
+	}
+}
+
+func BenchmarkRand_Float64s(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]float64, small)
+	b.SetBytes(int64(len(dst)) * 8)
+	for i := 0; i < b.N; i++ {
+		r.Float64s(dst)
+	}
+}
+
 func BenchmarkRand_Int(b *testing.B) {
 	var s int
 	r := rand.New(1)
@@ -400,6 +427,14 @@ func BenchmarkRand_NormFloat64(b *testing.B) {
 	sinkFloat64 = s
 }
 
+func BenchmarkRand_NormFloat64s(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]float64, small)
+	for i := 0; i < b.N; i++ {
+		r.NormFloat64s(dst)
+	}
+}
+
 func BenchmarkRand_Perm(b *testing.B) {
 	b.ReportAllocs()
 	r := rand.New(1)
@@ -496,6 +531,31 @@ func BenchmarkRand_Uint64n_Big(b *testing.B) {
 	sinkUint64 = s
 }
 
+func BenchmarkRand_Uint64s(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]uint64, small)
+	b.SetBytes(int64(len(dst)) * 8)
+	for i := 0; i < b.N; i++ {
+		r.Uint64s(dst)
+	}
+}
+
+func BenchmarkRand_Uint64ns(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]uint64, small)
+	for i := 0; i < b.N; i++ {
+		r.Uint64ns(dst, small)
+	}
+}
+
+func BenchmarkRand_Uint64ns_Big(b *testing.B) {
+	r := rand.New(1)
+	dst := make([]uint64, small)
+	for i := 0; i < b.N; i++ {
+		r.Uint64ns(dst, math.MaxUint64-small)
+	}
+}
+
 func BenchmarkRand_MarshalBinary(b *testing.B) {
 	b.ReportAllocs()
 	r := rand.New(1)
@@ -504,6 +564,15 @@ func BenchmarkRand_MarshalBinary(b *testing.B) {
 	}
 }
 
+func BenchmarkRand_AppendBinary(b *testing.B) {
+	b.ReportAllocs()
+	r := rand.New(1)
+	buf := make([]byte, 0, rand.StateSize)
+	for i := 0; i < b.N; i++ {
+		buf, _ = r.AppendBinary(buf[:0])
+	}
+}
+
 func BenchmarkRand_UnmarshalBinary(b *testing.B) {
 	b.ReportAllocs()
 	r := rand.New(1)
@@ -512,3 +581,12 @@ func BenchmarkRand_UnmarshalBinary(b *testing.B) {
 		_ = r.UnmarshalBinary(buf)
 	}
 }
+
+func BenchmarkRand_WriteRandom(b *testing.B) {
+	r := rand.New(1)
+	const n = 1 << 20
+	b.SetBytes(n)
+	for i := 0; i < b.N; i++ {
+		_, _ = r.WriteRandom(io.Discard, n)
+	}
+}
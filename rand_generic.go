@@ -37,3 +37,38 @@ func ShuffleSlice[S ~[]E, E any](r *Rand, s S) {
 		}
 	}
 }
+
+// ShufflePair pseudo-randomizes the order of the elements of a and b with the same permutation,
+// keeping the elements at equal indices together, e.g. features and their labels.
+// The elements of a end up in the same order as after ShuffleSlice(r, a). ShufflePair panics if len(a) != len(b).
+//
+// When r is nil, ShufflePair uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func ShufflePair[A any, B any](r *Rand, a []A, b []B) {
+	if len(a) != len(b) {
+		panic("invalid argument to ShufflePair")
+	}
+	ShuffleTogether(r, len(a), func(i, j int) {
+		a[i], a[j] = a[j], a[i]
+		b[i], b[j] = b[j], b[i]
+	})
+}
+
+// ShuffleTogether is like [Rand.Shuffle], but calls every one of swaps for each swap,
+// shuffling any number of parallel sequences of length n with the same permutation.
+// ShuffleTogether panics if n < 0.
+//
+// When r is nil, ShuffleTogether uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func ShuffleTogether(r *Rand, n int, swaps ...func(i, j int)) {
+	swap := func(i, j int) {
+		for _, s := range swaps {
+			s(i, j)
+		}
+	}
+	if r == nil {
+		Shuffle(n, swap)
+	} else {
+		r.Shuffle(n, swap)
+	}
+}
@@ -42,3 +42,23 @@ func TestShuffleSlice(t *testing.T) {
 		}
 	})
 }
+
+func TestShufflePair(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		a := make([]int, n)
+		b := make([]string, n)
+		c := make([]int, n)
+		for i := range a {
+			a[i], b[i], c[i] = i, string(rune('a'+i%26)), i
+		}
+		rand.ShufflePair(rand.New(s), a, b)
+		rand.ShuffleSlice(rand.New(s), c)
+		for i := range a {
+			if a[i] != c[i] || b[i] != string(rune('a'+a[i]%26)) {
+				t.Fatalf("elements at %v are %v, %q; expected %v", i, a[i], b[i], c[i])
+			}
+		}
+	})
+}
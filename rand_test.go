@@ -157,3 +157,215 @@ func TestRand_Uint32nOpt(t *testing.T) {
 		}
 	})
 }
+
+func TestRand_AppendBinary(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		prefix := rapid.SliceOf(rapid.Byte()).Draw(t, "prefix").([]byte)
+		r := rand.New(s)
+		r.Bits(rapid.IntRange(0, 64).Draw(t, "bits").(int))
+		data1, _ := r.MarshalBinary()
+		data2, err := r.AppendBinary(append([]byte(nil), prefix...))
+		if err != nil {
+			t.Fatalf("got unexpected append error: %v", err)
+		}
+		if len(data1) > rand.StateSize {
+			t.Fatalf("got %v bytes, more than %v", len(data1), rand.StateSize)
+		}
+		if !bytes.Equal(data2[:len(prefix)], prefix) || !bytes.Equal(data2[len(prefix):], data1) {
+			t.Fatalf("data %q / %q after append", data1, data2)
+		}
+	})
+}
+
+func TestRand_AppendBinary_Allocs(t *testing.T) {
+	r := rand.New(1)
+	buf := make([]byte, 0, rand.StateSize)
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = r.AppendBinary(buf[:0])
+	})
+	if allocs != 0 {
+		t.Fatalf("got %v allocations instead of 0", allocs)
+	}
+}
+
+func TestRand_State_Roundtrip(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, 16).Draw(t, "n").(int)
+		r1 := rand.New(s)
+		_, _ = r1.Read(make([]byte, n))
+		r1.Bits(n)
+		var r2 rand.Rand
+		if err := r2.SetState(r1.State()); err != nil {
+			t.Fatalf("got unexpected set state error: %v", err)
+		}
+		buf1 := make([]byte, 16)
+		buf2 := make([]byte, 16)
+		_, _ = r1.Read(buf1)
+		_, _ = r2.Read(buf2)
+		if !bytes.Equal(buf1, buf2) {
+			t.Fatalf("got %q instead of %q after state roundtrip", buf2, buf1)
+		}
+		if u, v := r1.Bits(n), r2.Bits(n); u != v {
+			t.Fatalf("got %v instead of %v after state roundtrip", v, u)
+		}
+	})
+}
+
+func TestRand_SetState_Invalid(t *testing.T) {
+	var r rand.Rand
+	if err := r.SetState([rand.StateWords]uint64{0, 0, 0, 1, 0, 9}); err == nil {
+		t.Fatalf("got no error for invalid state")
+	}
+}
+
+func TestRand_WriteRandom(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, 3*4096).Draw(t, "n").(int)
+		r := rand.New(s)
+		buf1 := make([]byte, n)
+		_, _ = r.Read(buf1)
+		r.Seed(s)
+		var buf2 bytes.Buffer
+		written, err := r.WriteRandom(&buf2, int64(n))
+		if err != nil {
+			t.Fatalf("got unexpected write error: %v", err)
+		}
+		if written != int64(n) {
+			t.Fatalf("got %v bytes written instead of %v", written, n)
+		}
+		if !bytes.Equal(buf1, buf2.Bytes()) {
+			t.Fatalf("got %q instead of %q", buf2.Bytes(), buf1)
+		}
+	})
+}
+
+func TestRand_IntRange(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lo := rapid.IntRange(math.MinInt, math.MaxInt-1).Draw(t, "lo").(int)
+		hi := rapid.IntRange(lo+1, math.MaxInt).Draw(t, "hi").(int)
+		r := rand.New(s)
+		v := r.IntRange(lo, hi)
+		if v < lo || v >= hi {
+			t.Fatalf("got %v outside of [%v, %v)", v, lo, hi)
+		}
+	})
+}
+
+func TestRand_Int64Range(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lo := rapid.Int64Range(math.MinInt64, math.MaxInt64-1).Draw(t, "lo").(int64)
+		hi := rapid.Int64Range(lo+1, math.MaxInt64).Draw(t, "hi").(int64)
+		r := rand.New(s)
+		v := r.Int64Range(lo, hi)
+		if v < lo || v >= hi {
+			t.Fatalf("got %v outside of [%v, %v)", v, lo, hi)
+		}
+	})
+}
+
+func TestRand_Int64Range_FullSpan(t *testing.T) {
+	r := rand.New(1)
+	neg, pos := false, false
+	for i := 0; i < 64; i++ {
+		v := r.Int64Range(math.MinInt64, math.MaxInt64)
+		neg = neg || v < 0
+		pos = pos || v > 0
+	}
+	if !neg || !pos {
+		t.Fatalf("full span draws are not spread over both signs")
+	}
+}
+
+func TestRand_Float64Full(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		r := rand.New(s)
+		f := r.Float64Full()
+		if f < 0 || f > 1 {
+			t.Fatalf("got %v outside of [0, 1]", f)
+		}
+	})
+}
+
+func TestRand_Float64Full_Resolution(t *testing.T) {
+	r := rand.New(1)
+	offGrid := 0
+	for i := 0; i < 1<<20; i++ {
+		f := r.Float64Full()
+		if f < 0x1.0p-10 && f*0x1.0p53 != math.Trunc(f*0x1.0p53) {
+			offGrid++
+		}
+	}
+	if offGrid == 0 {
+		t.Fatalf("all small values are multiples of 2^-53")
+	}
+}
+
+func TestRand_Uint128(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		r1 := rand.New(s)
+		r2 := rand.New(s)
+		hi, lo := r1.Uint128()
+		if hi2, lo2 := r2.Uint64(), r2.Uint64(); hi != hi2 || lo != lo2 {
+			t.Fatalf("Uint128() = %x, %x does not match two Uint64() calls %x, %x", hi, lo, hi2, lo2)
+		}
+	})
+}
+
+func TestNewV1(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		seed := rapid.SliceOfN(rapid.Uint64(), 0, 3).Draw(t, "seed").([]uint64)
+		if len(seed) == 0 {
+			seed = nil
+		}
+		r1 := rand.New(seed...)
+		r2 := rand.NewV1(seed...)
+		if len(seed) == 0 {
+			return // both are non-deterministic
+		}
+		// New is currently the same algorithm as NewV1
+		for i := 0; i < tiny; i++ {
+			if v1, v2 := r1.Uint64(), r2.Uint64(); v1 != v2 {
+				t.Fatalf("got %v from New and %v from NewV1", v1, v2)
+			}
+		}
+	})
+}
+
+func TestRand_Zero(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, 64).Draw(t, "n").(int)
+		r := rand.New(s)
+		r.Bits(n)
+		var p [3]byte
+		_, _ = r.Read(p[:])
+		_ = r.Close()
+		if r.State() != [rand.StateWords]uint64{} {
+			t.Fatalf("got state %v after Close", r.State())
+		}
+	})
+}
+
+func TestNewWorker(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		w := rapid.Uint64().Draw(t, "w").(uint64)
+		r1 := rand.NewWorker(s, w)
+		r2 := rand.NewWorker(s, w)
+		r3 := rand.NewWorker(s, w+1)
+		v := r1.Uint64()
+		if v2 := r2.Uint64(); v != v2 {
+			t.Fatalf("got %v and %v for the same worker", v, v2)
+		}
+		if v3 := r3.Uint64(); v == v3 {
+			t.Fatalf("got %v for workers %v and %v", v, w, w+1)
+		}
+	})
+}
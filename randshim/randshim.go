@@ -0,0 +1,328 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package randshim mirrors the API of math/rand on top of [github.com/gozelle/rand],
+// so that code written for math/rand can switch generators by changing only the import path.
+//
+// Until [Seed] is called, top-level functions use the fast goroutine-safe global generator
+// of [github.com/gozelle/rand]. After Seed, they use a single generator protected by a mutex
+// and produce a deterministic sequence, like math/rand does. The values produced are not
+// the same as the ones math/rand produces for the same seed.
+package randshim
+
+import (
+	"github.com/gozelle/rand"
+	mathrand "math/rand"
+	"sync"
+	"sync/atomic"
+)
+
+// A Source represents a source of uniformly-distributed pseudo-random int64 values in the range [0, 1<<63).
+type Source interface {
+	Int63() int64
+	Seed(seed int64)
+}
+
+// A Source64 is a [Source] that can also generate uniformly-distributed pseudo-random uint64 values.
+type Source64 interface {
+	Source
+	Uint64() uint64
+}
+
+// A Zipf generates Zipf distributed variates.
+type Zipf struct {
+	z interface{ Uint64() uint64 }
+}
+
+// Uint64 returns a value drawn from the Zipf distribution described by the Zipf object.
+func (z *Zipf) Uint64() uint64 { return z.z.Uint64() }
+
+// generator is the method set shared by [rand.Rand] and math/rand.Rand.
+type generator interface {
+	ExpFloat64() float64
+	Float32() float32
+	Float64() float64
+	Int() int
+	Int31() int32
+	Int31n(n int32) int32
+	Int63() int64
+	Int63n(n int64) int64
+	Intn(n int) int
+	NormFloat64() float64
+	Perm(n int) []int
+	Read(p []byte) (n int, err error)
+	Shuffle(n int, swap func(i, j int))
+	Uint32() uint32
+	Uint64() uint64
+}
+
+// A Rand is a source of random numbers with the method set of math/rand.Rand.
+type Rand struct {
+	g generator
+	r *rand.Rand     // set when created by NewSource
+	m *mathrand.Rand // set when created by New from some other Source
+}
+
+// NewSource returns a new pseudo-random [Source] seeded with the given value.
+// The returned source is a *[Rand] and implements [Source64].
+func NewSource(seed int64) Source {
+	r := rand.New(uint64(seed))
+	return &Rand{g: r, r: r}
+}
+
+// New returns a new Rand that uses random values from src to generate other random values.
+// When src was returned by [NewSource], the returned Rand shares its state with src
+// and generates values with [github.com/gozelle/rand]. Any other src is wrapped
+// and used exactly like math/rand.New would use it.
+func New(src Source) *Rand {
+	if r, ok := src.(*Rand); ok {
+		return r
+	}
+	m := mathrand.New(src)
+	return &Rand{g: m, m: m}
+}
+
+// NewZipf returns a Zipf variate generator. See math/rand.NewZipf for details.
+func NewZipf(r *Rand, s float64, v float64, imax uint64) *Zipf {
+	if r.m != nil {
+		if z := mathrand.NewZipf(r.m, s, v, imax); z != nil {
+			return &Zipf{z: z}
+		}
+		return nil
+	}
+	if z := rand.NewZipf(r.r, s, v, imax); z != nil {
+		return &Zipf{z: z}
+	}
+	return nil
+}
+
+// Seed uses the provided seed value to initialize the generator to a deterministic state.
+func (r *Rand) Seed(seed int64) {
+	if r.m != nil {
+		r.m.Seed(seed)
+		return
+	}
+	r.r.Seed(uint64(seed))
+}
+
+// ExpFloat64 calls [rand.Rand.ExpFloat64].
+func (r *Rand) ExpFloat64() float64 { return r.g.ExpFloat64() }
+
+// Float32 calls [rand.Rand.Float32].
+func (r *Rand) Float32() float32 { return r.g.Float32() }
+
+// Float64 calls [rand.Rand.Float64].
+func (r *Rand) Float64() float64 { return r.g.Float64() }
+
+// Int calls [rand.Rand.Int].
+func (r *Rand) Int() int { return r.g.Int() }
+
+// Int31 calls [rand.Rand.Int31].
+func (r *Rand) Int31() int32 { return r.g.Int31() }
+
+// Int31n calls [rand.Rand.Int31n].
+func (r *Rand) Int31n(n int32) int32 { return r.g.Int31n(n) }
+
+// Int63 calls [rand.Rand.Int63].
+func (r *Rand) Int63() int64 { return r.g.Int63() }
+
+// Int63n calls [rand.Rand.Int63n].
+func (r *Rand) Int63n(n int64) int64 { return r.g.Int63n(n) }
+
+// Intn calls [rand.Rand.Intn].
+func (r *Rand) Intn(n int) int { return r.g.Intn(n) }
+
+// NormFloat64 calls [rand.Rand.NormFloat64].
+func (r *Rand) NormFloat64() float64 { return r.g.NormFloat64() }
+
+// Perm calls [rand.Rand.Perm].
+func (r *Rand) Perm(n int) []int { return r.g.Perm(n) }
+
+// Read calls [rand.Rand.Read].
+func (r *Rand) Read(p []byte) (n int, err error) { return r.g.Read(p) }
+
+// Shuffle calls [rand.Rand.Shuffle].
+func (r *Rand) Shuffle(n int, swap func(i, j int)) { r.g.Shuffle(n, swap) }
+
+// Uint32 calls [rand.Rand.Uint32].
+func (r *Rand) Uint32() uint32 { return r.g.Uint32() }
+
+// Uint64 calls [rand.Rand.Uint64].
+func (r *Rand) Uint64() uint64 { return r.g.Uint64() }
+
+var (
+	seeded   uint32 // accessed atomically
+	globalMu sync.Mutex
+	global   = rand.New(1)
+)
+
+// lock returns the seeded global generator with the mutex held, or nil if Seed was never called.
+func lock() *rand.Rand {
+	if atomic.LoadUint32(&seeded) == 0 {
+		return nil
+	}
+	globalMu.Lock()
+	return global
+}
+
+// Seed uses the provided seed value to initialize the default source to a deterministic state.
+// After Seed, top-level functions are serialized through a mutex.
+func Seed(seed int64) {
+	globalMu.Lock()
+	global.Seed(uint64(seed))
+	globalMu.Unlock()
+	atomic.StoreUint32(&seeded, 1)
+}
+
+// ExpFloat64 returns an exponentially distributed float64 in the range (0, +math.MaxFloat64]
+// with an exponential distribution whose rate parameter (lambda) is 1 and whose mean is 1/lambda (1).
+func ExpFloat64() float64 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.ExpFloat64()
+	}
+	return rand.ExpFloat64()
+}
+
+// Float32 returns, as a float32, a pseudo-random number in the half-open interval [0.0, 1.0).
+func Float32() float32 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Float32()
+	}
+	return rand.Float32()
+}
+
+// Float64 returns, as a float64, a pseudo-random number in the half-open interval [0.0, 1.0).
+func Float64() float64 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Float64()
+	}
+	return rand.Float64()
+}
+
+// Int returns a non-negative pseudo-random int.
+func Int() int {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Int()
+	}
+	return rand.Int()
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer as an int32.
+func Int31() int32 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Int31()
+	}
+	return rand.Int31()
+}
+
+// Int31n returns, as an int32, a non-negative pseudo-random number in the half-open interval [0, n).
+// It panics if n <= 0.
+func Int31n(n int32) int32 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Int31n(n)
+	}
+	return rand.Int31n(n)
+}
+
+// Int63 returns a non-negative pseudo-random 63-bit integer as an int64.
+func Int63() int64 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Int63()
+	}
+	return rand.Int63()
+}
+
+// Int63n returns, as an int64, a non-negative pseudo-random number in the half-open interval [0, n).
+// It panics if n <= 0.
+func Int63n(n int64) int64 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Int63n(n)
+	}
+	return rand.Int63n(n)
+}
+
+// Intn returns, as an int, a non-negative pseudo-random number in the half-open interval [0, n).
+// It panics if n <= 0.
+func Intn(n int) int {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Intn(n)
+	}
+	return rand.Intn(n)
+}
+
+// NormFloat64 returns a normally distributed float64 in the range [-math.MaxFloat64, +math.MaxFloat64]
+// with standard normal distribution (mean = 0, stddev = 1).
+func NormFloat64() float64 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.NormFloat64()
+	}
+	return rand.NormFloat64()
+}
+
+// Perm returns, as a slice of n ints, a pseudo-random permutation of the integers in the half-open interval [0, n).
+func Perm(n int) []int {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Perm(n)
+	}
+	return rand.Perm(n)
+}
+
+// Read generates len(p) random bytes and writes them into p. It always returns len(p) and a nil error.
+func Read(p []byte) (n int, err error) {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Read(p)
+	}
+	return rand.Read(p)
+}
+
+// Shuffle pseudo-randomizes the order of elements. n is the number of elements. Shuffle panics if n < 0.
+// swap swaps the elements with indexes i and j.
+func Shuffle(n int, swap func(i, j int)) {
+	if atomic.LoadUint32(&seeded) == 0 {
+		rand.Shuffle(n, swap)
+		return
+	}
+	if n < 0 {
+		panic("invalid argument to Shuffle")
+	}
+	// swap is called without the mutex held, so that it can use the top-level functions itself
+	for i := n - 1; i > 0; i-- {
+		globalMu.Lock()
+		j := global.Intn(i + 1)
+		globalMu.Unlock()
+		swap(i, j)
+	}
+}
+
+// Uint32 returns a pseudo-random 32-bit value as a uint32.
+func Uint32() uint32 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Uint32()
+	}
+	return rand.Uint32()
+}
+
+// Uint64 returns a pseudo-random 64-bit value as a uint64.
+func Uint64() uint64 {
+	if r := lock(); r != nil {
+		defer globalMu.Unlock()
+		return r.Uint64()
+	}
+	return rand.Uint64()
+}
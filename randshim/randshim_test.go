@@ -0,0 +1,94 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package randshim_test
+
+import (
+	"github.com/gozelle/rand/randshim"
+	"testing"
+)
+
+func TestSeed(t *testing.T) {
+	draw := func() []int64 {
+		return []int64{randshim.Int63(), int64(randshim.Intn(10)), int64(randshim.Float64() * 1000), int64(randshim.Perm(5)[0])}
+	}
+	randshim.Seed(42)
+	a := draw()
+	randshim.Seed(42)
+	b := draw()
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("got %v after reseeding instead of %v", b, a)
+		}
+	}
+}
+
+func TestNewSource(t *testing.T) {
+	src := randshim.NewSource(7)
+	if _, ok := src.(randshim.Source64); !ok {
+		t.Fatal("NewSource did not return a Source64")
+	}
+	r := randshim.New(src)
+	x := r.Uint64()
+	src.Seed(7)
+	if y := r.Uint64(); y != x {
+		t.Fatalf("got %v after reseeding the source instead of %v", y, x)
+	}
+	if z := randshim.NewZipf(r, 2, 1, 10); z == nil || z.Uint64() > 10 {
+		t.Fatal("NewZipf returned an invalid generator")
+	}
+}
+
+// lcgSource is a Source that is not created by NewSource.
+type lcgSource struct{ x uint64 }
+
+func (s *lcgSource) Int63() int64 {
+	s.x = s.x*6364136223846793005 + 1442695040888963407
+	return int64(s.x >> 1)
+}
+
+func (s *lcgSource) Seed(seed int64) { s.x = uint64(seed) }
+
+func TestNew_CustomSource(t *testing.T) {
+	r := randshim.New(&lcgSource{})
+	want := &lcgSource{}
+	if x, y := r.Int63(), want.Int63(); x != y {
+		t.Fatalf("got %v instead of %v from the source", x, y)
+	}
+	r.Seed(100)
+	want.Seed(100)
+	if x, y := r.Int63(), want.Int63(); x != y {
+		t.Fatalf("got %v after reseeding instead of %v", x, y)
+	}
+	if x := r.Intn(10); x < 0 || x >= 10 {
+		t.Fatalf("Intn(10) returned %v", x)
+	}
+	r.Shuffle(5, func(i, j int) {})
+	if z := randshim.NewZipf(r, 2, 1, 10); z == nil || z.Uint64() > 10 {
+		t.Fatal("NewZipf returned an invalid generator")
+	}
+	if z := randshim.NewZipf(r, 0.5, 1, 10); z != nil {
+		t.Fatal("NewZipf accepted invalid parameters")
+	}
+}
+
+func TestShuffle_Reentrant(t *testing.T) {
+	randshim.Seed(1)
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	randshim.Shuffle(len(s), func(i, j int) {
+		_ = randshim.Intn(10)
+		s[i], s[j] = s[j], s[i]
+	})
+	seen := make([]bool, len(s))
+	for _, v := range s {
+		seen[v] = true
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Fatalf("%v missing after Shuffle: %v", i, s)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package randtest
+
+import (
+	"fmt"
+	"github.com/gozelle/rand/internal/stats"
+	"io"
+	"math"
+)
+
+// The tests below are described in NIST SP 800-22 rev. 1a, "A Statistical Test Suite for Random
+// and Pseudorandom Number Generators for Cryptographic Applications". They treat data as a sequence
+// of bits, most significant bit of every byte first, and detect only gross defects of a source.
+
+// BlockFrequencySize is the block size in bits used by [CheckBits] for the block frequency test.
+const BlockFrequencySize = 128
+
+// Monobit performs the frequency (monobit) test: it checks that the numbers of zeros and ones
+// in data are approximately the same. It returns the p-value.
+func Monobit(data []byte) (p float64) {
+	if len(data) == 0 {
+		panic("invalid argument to Monobit")
+	}
+	return stats.Monobit(data)
+}
+
+// Runs performs the runs test: it checks that the number of runs of identical bits in data
+// is the one expected of a random sequence. It returns the p-value, which is zero
+// when data fails the prerequisite frequency check.
+func Runs(data []byte) (p float64) {
+	if len(data) == 0 {
+		panic("invalid argument to Runs")
+	}
+	n := float64(len(data) * 8)
+	pi := float64(stats.OnesCount(data)) / n
+	if math.Abs(pi-0.5) >= 2/math.Sqrt(n) {
+		return 0
+	}
+	return stats.Runs(data)
+}
+
+// BlockFrequency performs the frequency test within blocks of blockSize bits: it checks that
+// the proportion of ones in every block is approximately 1/2. Bits that do not form a complete
+// block are ignored. It returns the p-value. BlockFrequency panics if blockSize is not
+// a positive multiple of 8, or data is shorter than one block.
+func BlockFrequency(data []byte, blockSize int) (p float64) {
+	if blockSize <= 0 || blockSize%8 != 0 || len(data) < blockSize/8 {
+		panic("invalid argument to BlockFrequency")
+	}
+	m := blockSize / 8
+	blocks := len(data) / m
+	var chi2 float64
+	for i := 0; i < blocks; i++ {
+		d := float64(stats.OnesCount(data[i*m:(i+1)*m]))/float64(blockSize) - 0.5
+		chi2 += d * d
+	}
+	chi2 *= 4 * float64(blockSize)
+	return stats.GammaQ(float64(blocks)/2, chi2/2)
+}
+
+// CheckBits reads n bytes from src and runs the [Monobit], [Runs] and [BlockFrequency] tests on them.
+// It returns a non-nil error if reading fails or any of the tests has a p-value below alpha.
+// NIST recommends n of at least 12500 (100000 bits) and alpha of 0.01; with many sources to check,
+// use a smaller alpha to keep the number of false alarms low. CheckBits panics if n < BlockFrequencySize/8.
+func CheckBits(src io.Reader, n int, alpha float64) error {
+	if n < BlockFrequencySize/8 {
+		panic("invalid argument to CheckBits")
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(src, data); err != nil {
+		return err
+	}
+	tests := [...]struct {
+		name string
+		p    float64
+	}{
+		{"monobit", Monobit(data)},
+		{"runs", Runs(data)},
+		{"block frequency", BlockFrequency(data, BlockFrequencySize)},
+	}
+	for _, test := range tests {
+		if test.p < alpha {
+			return fmt.Errorf("%v test failed: p = %v", test.name, test.p)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package randtest implements goodness-of-fit tests useful for validating
+// samplers built on top of [github.com/gozelle/rand].
+//
+// All functions return a p-value: the probability of observing a result
+// at least as extreme as the one observed, given the hypothesized
+// distribution is correct. Small p-values indicate the samples are
+// unlikely to come from the hypothesized distribution; keep in mind that
+// a correct sampler will produce p-values below α with probability α.
+package randtest
+
+import (
+	"fmt"
+	"github.com/gozelle/rand/internal/stats"
+	"math"
+	"sort"
+)
+
+// ChiSquared performs Pearson's chi-squared test of observed counts against expected ones,
+// with len(observed)-1 degrees of freedom. It returns the χ² statistic and the p-value.
+// It panics if the slices have different lengths or fewer than 2 elements.
+func ChiSquared(observed []int, expected []float64) (chi2 float64, p float64) {
+	if len(observed) != len(expected) || len(observed) < 2 {
+		panic("invalid argument to ChiSquared")
+	}
+	return stats.ChiSquared(observed, expected)
+}
+
+// KolmogorovSmirnov performs the one-sample Kolmogorov–Smirnov test of samples against
+// the continuous cumulative distribution function cdf. It returns the D statistic and
+// the (asymptotic) p-value. samples are sorted in place. It panics if samples is empty.
+func KolmogorovSmirnov(samples []float64, cdf func(float64) float64) (d float64, p float64) {
+	if len(samples) == 0 {
+		panic("invalid argument to KolmogorovSmirnov")
+	}
+	sort.Float64s(samples)
+	n := float64(len(samples))
+	for i, x := range samples {
+		f := cdf(x)
+		d = math.Max(d, math.Max(f-float64(i)/n, float64(i+1)/n-f))
+	}
+	sn := math.Sqrt(n)
+	return d, kolmogorovQ((sn + 0.12 + 0.11/sn) * d)
+}
+
+// CheckUniform returns a non-nil error if any of the samples lies outside of
+// the half-open interval [lo, hi), or if the Kolmogorov–Smirnov test rejects
+// the hypothesis of samples being uniformly distributed at the significance level alpha.
+// samples are sorted in place. It panics if samples is empty or lo >= hi.
+func CheckUniform(samples []float64, lo float64, hi float64, alpha float64) error {
+	if len(samples) == 0 || !(lo < hi) {
+		panic("invalid argument to CheckUniform")
+	}
+	for _, x := range samples {
+		if !(x >= lo && x < hi) {
+			return fmt.Errorf("sample %v is outside of [%v, %v)", x, lo, hi)
+		}
+	}
+	d, p := KolmogorovSmirnov(samples, func(x float64) float64 {
+		return (x - lo) / (hi - lo)
+	})
+	if p < alpha {
+		return fmt.Errorf("samples are not uniform on [%v, %v): D = %v, p = %v", lo, hi, d, p)
+	}
+	return nil
+}
+
+// kolmogorovQ returns the complementary cumulative distribution function of the Kolmogorov distribution.
+func kolmogorovQ(lambda float64) float64 {
+	if lambda < 0.2 {
+		return 1
+	}
+	var sum float64
+	sign := 1.0
+	for j := 1.0; j < 100; j++ {
+		term := sign * math.Exp(-2*j*j*lambda*lambda)
+		sum += term
+		if math.Abs(term) <= 1e-16*math.Abs(sum) {
+			break
+		}
+		sign = -sign
+	}
+	return math.Max(0, math.Min(1, 2*sum))
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package randtest_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"testing"
+)
+
+func TestChiSquared(t *testing.T) {
+	// reference p-value is the closed-form χ² survival function for 5 degrees of freedom
+	chi2, p := randtest.ChiSquared([]int{16, 18, 16, 14, 12, 12}, []float64{16, 16, 16, 16, 16, 8})
+	if math.Abs(chi2-3.5) > 1e-12 || math.Abs(p-0.6233876277495822) > 1e-9 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+	chi2, p = randtest.ChiSquared([]int{100, 0}, []float64{50, 50})
+	if chi2 != 100 || p > 1e-20 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+}
+
+func TestChiSquared_Uniform(t *testing.T) {
+	r := rand.New(1)
+	const n, iters = 100, 100000
+	counts := make([]int, n)
+	expected := make([]float64, n)
+	for i := range expected {
+		expected[i] = iters / n
+	}
+	for i := 0; i < iters; i++ {
+		counts[r.Intn(n)]++
+	}
+	if chi2, p := randtest.ChiSquared(counts, expected); p < 1e-4 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+}
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	r := rand.New(1)
+	samples := make([]float64, 10000)
+	for i := range samples {
+		samples[i] = r.NormFloat64()
+	}
+	normalCDF := func(x float64) float64 { return 0.5 * math.Erfc(-x/math.Sqrt2) }
+	if d, p := randtest.KolmogorovSmirnov(samples, normalCDF); p < 1e-4 {
+		t.Errorf("normal samples rejected: D = %v, p = %v", d, p)
+	}
+	for i := range samples {
+		samples[i] = r.ExpFloat64()
+	}
+	if d, p := randtest.KolmogorovSmirnov(samples, normalCDF); p > 1e-4 {
+		t.Errorf("exponential samples accepted as normal: D = %v, p = %v", d, p)
+	}
+}
+
+func TestCheckUniform(t *testing.T) {
+	r := rand.New(1)
+	samples := make([]float64, 10000)
+	for i := range samples {
+		samples[i] = r.Float64()*2 - 1
+	}
+	if err := randtest.CheckUniform(samples, -1, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+	for i := range samples {
+		samples[i] = math.Sqrt(r.Float64())
+	}
+	if err := randtest.CheckUniform(samples, 0, 1, 1e-4); err == nil {
+		t.Error("non-uniform samples accepted")
+	}
+	samples[0] = 1
+	if err := randtest.CheckUniform(samples, 0, 1, 0); err == nil {
+		t.Error("out of range sample accepted")
+	}
+}
+
+func TestNIST_Reference(t *testing.T) {
+	data := []byte{0xff, 0x00}
+	// 8 ones and 8 zeros, 2 runs of 8 bits, 2 blocks of 8 bits with proportions 1 and 0
+	for _, c := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"monobit", randtest.Monobit(data), 1},
+		{"runs", randtest.Runs(data), math.Erfc(6 / (2 * math.Sqrt(32) * 0.25))},
+		{"block frequency", randtest.BlockFrequency(data, 8), math.Exp(-8)},
+	} {
+		if math.Abs(c.got-c.want) > 1e-12 {
+			t.Errorf("%v: got p = %v instead of %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestCheckBits(t *testing.T) {
+	if err := randtest.CheckBits(rand.NewReader(1), 12500, 1e-4); err != nil {
+		t.Error(err)
+	}
+	if err := randtest.CheckBits(&constReader{0x55}, 12500, 1e-4); err == nil {
+		t.Error("alternating bits passed the tests")
+	}
+	if err := randtest.CheckBits(&constReader{0xf0}, 12500, 1e-4); err == nil {
+		t.Error("bits in runs of 4 passed the tests")
+	}
+}
+
+type constReader struct {
+	b byte
+}
+
+func (c *constReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = c.b
+	}
+	return len(p), nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "io"
+
+type reader struct {
+	r Rand
+}
+
+// NewReader returns an infinite deterministic stream of pseudo-random bytes.
+// The stream is the same as the one produced by calling Read on New(seed).
+// Reads from the returned reader always fill the whole buffer and never return an error.
+func NewReader(seed uint64) io.Reader {
+	rd := &reader{}
+	rd.r.init1(seed)
+	return rd
+}
+
+func (rd *reader) Read(p []byte) (n int, err error) {
+	return rd.r.Read(p)
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"bytes"
+	"github.com/gozelle/rand"
+	"io"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestNewReader(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		buf1 := make([]byte, n)
+		_, _ = rand.New(s).Read(buf1)
+		buf2, err := io.ReadAll(io.LimitReader(rand.NewReader(s), int64(n)))
+		if err != nil {
+			t.Fatalf("got unexpected read error: %v", err)
+		}
+		if !bytes.Equal(buf1, buf2) {
+			t.Fatalf("got %q instead of %q", buf2, buf1)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// A RecencyWeighted selects indices of a sequence of n items, with weights decaying exponentially
+// with the age of an item. The last item (index n-1) is the most recent one and has age 0;
+// the weight of an item halves every halfLife steps of age. RecencyWeighted is immutable and safe
+// for concurrent use, as long as each goroutine uses its own [Rand].
+type RecencyWeighted struct {
+	n    int
+	logQ float64 // log of the weight ratio between consecutive ages
+	mass float64 // 1 - q^n
+}
+
+// NewRecencyWeighted returns a RecencyWeighted for n items with the given half-life.
+// Infinite halfLife makes all items equally likely.
+// NewRecencyWeighted panics if n <= 0 or halfLife is not positive.
+func NewRecencyWeighted(n int, halfLife float64) *RecencyWeighted {
+	if n <= 0 || !(halfLife > 0) {
+		panic("invalid argument to NewRecencyWeighted")
+	}
+	logQ := -math.Ln2 / halfLife
+	return &RecencyWeighted{n: n, logQ: logQ, mass: -math.Expm1(float64(n) * logQ)}
+}
+
+// N returns the number of items.
+func (rw *RecencyWeighted) N() int {
+	return rw.n
+}
+
+// Index returns an index in the half-open interval [0, n), using r as the source.
+func (rw *RecencyWeighted) Index(r *Rand) int {
+	if rw.logQ == 0 {
+		return r.Intn(rw.n)
+	}
+	// inversion of the truncated geometric distribution of ages
+	age := int(math.Log1p(-r.Float64()*rw.mass) / rw.logQ)
+	if age >= rw.n {
+		age = rw.n - 1
+	}
+	return rw.n - 1 - age
+}
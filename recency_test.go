@@ -0,0 +1,54 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRecencyWeighted_InRange(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, small).Draw(t, "n").(int)
+		h := rapid.Float64Range(1e-3, 1e6).Draw(t, "h").(float64)
+		r := rand.New(s)
+		rw := rand.NewRecencyWeighted(n, h)
+		for i := 0; i < 16; i++ {
+			if ix := rw.Index(r); ix < 0 || ix >= n {
+				t.Fatalf("got index %v outside of [0, %v)", ix, n)
+			}
+		}
+	})
+}
+
+func TestRecencyWeighted_Distribution(t *testing.T) {
+	for _, h := range []float64{0.5, 3, math.Inf(1)} {
+		const n, samples = 10, 100000
+		r := rand.New(1)
+		rw := rand.NewRecencyWeighted(n, h)
+		observed := make([]int, n)
+		for i := 0; i < samples; i++ {
+			observed[rw.Index(r)]++
+		}
+		expected := make([]float64, n)
+		var sum float64
+		for i := range expected {
+			expected[i] = math.Exp2(-float64(n-1-i) / h)
+			sum += expected[i]
+		}
+		for i := range expected {
+			expected[i] *= samples / sum
+		}
+		if _, p := randtest.ChiSquared(observed, expected); p < 1e-4 {
+			t.Errorf("half-life %v: got p-value %v for %v", h, p, observed)
+		}
+	}
+}
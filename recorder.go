@@ -0,0 +1,145 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// A Snapshot is the state of a generator recorded by [Recorder] before the given draw.
+type Snapshot struct {
+	Draw  uint64
+	State [StateWords]uint64
+}
+
+// A Recorder wraps a [Rand], saving its state every few draws into a fixed-size ring of snapshots.
+// Long simulations can be rewound to a recorded point close to a failure and re-run from there
+// without storing the full log of drawn values, as [Recording] does.
+type Recorder struct {
+	r     *Rand
+	every uint64
+	draws uint64
+	ring  []Snapshot
+	start int // index of the oldest snapshot
+	n     int // number of snapshots in the ring
+}
+
+// NewRecorder returns a Recorder drawing values from r, which saves the state of r
+// before every draw number divisible by every, keeping the last size snapshots.
+// NewRecorder panics if every == 0 or size <= 0.
+func NewRecorder(r *Rand, every uint64, size int) *Recorder {
+	if every == 0 || size <= 0 {
+		panic("invalid argument to NewRecorder")
+	}
+	return &Recorder{r: r, every: every, ring: make([]Snapshot, size)}
+}
+
+// Draws returns the number of values drawn through the Recorder.
+func (rc *Recorder) Draws() uint64 {
+	return rc.draws
+}
+
+// Snapshots returns the recorded snapshots, oldest first.
+func (rc *Recorder) Snapshots() []Snapshot {
+	s := make([]Snapshot, rc.n)
+	for i := range s {
+		s[i] = rc.ring[(rc.start+i)%len(rc.ring)]
+	}
+	return s
+}
+
+// Rewind restores the generator to the latest snapshot taken at or before draw,
+// discarding the snapshots after it. It returns the draw number of the restored snapshot;
+// drawing continues from that point, reproducing the values drawn after it originally.
+// If no such snapshot is left in the ring, Rewind returns false and leaves the generator unchanged.
+func (rc *Recorder) Rewind(draw uint64) (uint64, bool) {
+	for i := rc.n - 1; i >= 0; i-- {
+		s := &rc.ring[(rc.start+i)%len(rc.ring)]
+		if s.Draw <= draw {
+			_ = rc.r.SetState(s.State)
+			rc.draws = s.Draw
+			rc.n = i // the snapshot is retaken before the next draw
+			return s.Draw, true
+		}
+	}
+	return 0, false
+}
+
+func (rc *Recorder) step() {
+	if rc.draws%rc.every == 0 {
+		i := (rc.start + rc.n) % len(rc.ring)
+		if rc.n == len(rc.ring) {
+			rc.start = (rc.start + 1) % len(rc.ring)
+		} else {
+			rc.n++
+		}
+		rc.ring[i] = Snapshot{Draw: rc.draws, State: rc.r.State()}
+	}
+	rc.draws++
+}
+
+// ExpFloat64 calls [Rand.ExpFloat64], taking a snapshot first if one is due.
+func (rc *Recorder) ExpFloat64() float64 {
+	rc.step()
+	return rc.r.ExpFloat64()
+}
+
+// Float64 calls [Rand.Float64], taking a snapshot first if one is due.
+func (rc *Recorder) Float64() float64 {
+	rc.step()
+	return rc.r.Float64()
+}
+
+// Int calls [Rand.Int], taking a snapshot first if one is due.
+func (rc *Recorder) Int() int {
+	rc.step()
+	return rc.r.Int()
+}
+
+// Int63 calls [Rand.Int63], taking a snapshot first if one is due.
+func (rc *Recorder) Int63() int64 {
+	rc.step()
+	return rc.r.Int63()
+}
+
+// Int63n calls [Rand.Int63n], taking a snapshot first if one is due.
+func (rc *Recorder) Int63n(n int64) int64 {
+	rc.step()
+	return rc.r.Int63n(n)
+}
+
+// Intn calls [Rand.Intn], taking a snapshot first if one is due.
+func (rc *Recorder) Intn(n int) int {
+	rc.step()
+	return rc.r.Intn(n)
+}
+
+// NormFloat64 calls [Rand.NormFloat64], taking a snapshot first if one is due.
+func (rc *Recorder) NormFloat64() float64 {
+	rc.step()
+	return rc.r.NormFloat64()
+}
+
+// Uint32 calls [Rand.Uint32], taking a snapshot first if one is due.
+func (rc *Recorder) Uint32() uint32 {
+	rc.step()
+	return rc.r.Uint32()
+}
+
+// Uint32n calls [Rand.Uint32n], taking a snapshot first if one is due.
+func (rc *Recorder) Uint32n(n uint32) uint32 {
+	rc.step()
+	return rc.r.Uint32n(n)
+}
+
+// Uint64 calls [Rand.Uint64], taking a snapshot first if one is due.
+func (rc *Recorder) Uint64() uint64 {
+	rc.step()
+	return rc.r.Uint64()
+}
+
+// Uint64n calls [Rand.Uint64n], taking a snapshot first if one is due.
+func (rc *Recorder) Uint64n(n uint64) uint64 {
+	rc.step()
+	return rc.r.Uint64n(n)
+}
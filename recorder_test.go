@@ -0,0 +1,66 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRecorder_Rewind(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		every := rapid.Uint64Range(1, 10).Draw(t, "every").(uint64)
+		size := rapid.IntRange(1, 10).Draw(t, "size").(int)
+		n := rapid.IntRange(0, 200).Draw(t, "n").(int)
+		to := rapid.Uint64Range(0, uint64(n)).Draw(t, "to").(uint64)
+
+		rc := rand.NewRecorder(rand.New(s), every, size)
+		vals := make([]uint64, n)
+		for i := range vals {
+			if i%3 == 0 {
+				vals[i] = uint64(rc.Intn(100))
+			} else {
+				vals[i] = rc.Uint64()
+			}
+		}
+		snaps := rc.Snapshots()
+		if len(snaps) > size {
+			t.Fatalf("got %v snapshots with ring of size %v", len(snaps), size)
+		}
+
+		d, ok := rc.Rewind(to)
+		oldest := uint64(n)
+		if len(snaps) > 0 {
+			oldest = snaps[0].Draw
+		}
+		if ok != (len(snaps) > 0 && oldest <= to) {
+			t.Fatalf("Rewind(%v) returned %v with oldest snapshot at %v", to, ok, oldest)
+		}
+		if !ok {
+			return
+		}
+		if d > to || d%every != 0 || to-d >= every && d+every < uint64(n) {
+			t.Fatalf("Rewind(%v) restored snapshot at %v, every %v", to, d, every)
+		}
+		if rc.Draws() != d {
+			t.Fatalf("got %v draws after rewind to %v", rc.Draws(), d)
+		}
+		for i := int(d); i < n; i++ {
+			var v uint64
+			if i%3 == 0 {
+				v = uint64(rc.Intn(100))
+			} else {
+				v = rc.Uint64()
+			}
+			if v != vals[i] {
+				t.Fatalf("draw %v after rewind: got %v instead of %v", i, v, vals[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	regexpMaxAttempts = 1000
+
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+)
+
+// A Regexp generates pseudo-random strings matching a regular expression.
+type Regexp struct {
+	re        *syntax.Regexp
+	maxRepeat int
+	verify    *regexp.Regexp // set only when the expression has assertions that generation does not enforce
+}
+
+// NewRegexp returns a generator of strings matching re in full. Unbounded repetitions
+// (such as x*, x+ or x{n,}) repeat at most max(n, maxRepeat) times.
+// NewRegexp panics if maxRepeat < 0.
+func NewRegexp(re *syntax.Regexp, maxRepeat int) *Regexp {
+	if maxRepeat < 0 {
+		panic("invalid argument to NewRegexp")
+	}
+	g := &Regexp{re: re, maxRepeat: maxRepeat}
+	if hasAssertions(re) {
+		g.verify = regexp.MustCompile(`^(?:` + re.String() + `)$`)
+	}
+	return g
+}
+
+// ParseRegexp parses expr using Perl syntax, and returns a generator of strings matching it in full,
+// as described in [NewRegexp].
+func ParseRegexp(expr string, maxRepeat int) (*Regexp, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	return NewRegexp(re, maxRepeat), nil
+}
+
+// Generate returns a pseudo-random string matching the regular expression, using r as the source.
+// Assertions like ^, $ or \b are satisfied by rejecting strings that violate them, and
+// Generate panics if it fails to produce a matching string after many attempts
+// (for example, for expressions like a^b that match nothing).
+func (g *Regexp) Generate(r *Rand) string {
+	var sb strings.Builder
+	for i := 0; i < regexpMaxAttempts; i++ {
+		sb.Reset()
+		if g.generate(r, &sb, g.re) && (g.verify == nil || g.verify.MatchString(sb.String())) {
+			return sb.String()
+		}
+	}
+	panic("rand: failed to generate a string matching " + g.re.String())
+}
+
+func (g *Regexp) generate(r *Rand, sb *strings.Builder, re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return false
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		// assertions are checked after the generation
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				c = randomFold(r, c)
+			}
+			sb.WriteRune(c)
+		}
+	case syntax.OpCharClass:
+		c, ok := randomClassRune(r, re.Rune)
+		if !ok {
+			return false
+		}
+		sb.WriteRune(c)
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		for {
+			c := randomRune(r)
+			if c != '\n' || re.Op == syntax.OpAnyChar {
+				sb.WriteRune(c)
+				break
+			}
+		}
+	case syntax.OpCapture:
+		return g.generate(r, sb, re.Sub[0])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		lo, hi := 0, -1
+		switch re.Op {
+		case syntax.OpPlus:
+			lo = 1
+		case syntax.OpQuest:
+			hi = 1
+		case syntax.OpRepeat:
+			lo, hi = re.Min, re.Max
+		}
+		if hi < 0 {
+			hi = lo
+			if g.maxRepeat > hi {
+				hi = g.maxRepeat
+			}
+		}
+		n := lo + r.Intn(hi-lo+1)
+		for i := 0; i < n; i++ {
+			if !g.generate(r, sb, re.Sub[0]) {
+				return false
+			}
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !g.generate(r, sb, sub) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		return g.generate(r, sb, re.Sub[r.Intn(len(re.Sub))])
+	}
+	return true
+}
+
+func hasAssertions(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return true
+	}
+	for _, sub := range re.Sub {
+		if hasAssertions(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// randomRune returns a uniformly distributed pseudo-random valid Unicode code point.
+func randomRune(r *Rand) rune {
+	const n = unicode.MaxRune + 1 - (surrogateMax - surrogateMin + 1)
+	c := rune(r.Uint32n(n))
+	if c >= surrogateMin {
+		c += surrogateMax - surrogateMin + 1
+	}
+	return c
+}
+
+// randomClassRune returns a uniformly distributed pseudo-random valid code point
+// from a character class represented as a list of inclusive ranges.
+func randomClassRune(r *Rand, ranges []rune) (rune, bool) {
+	var total uint32
+	for i := 0; i < len(ranges); i += 2 {
+		total += uint32(ranges[i+1]-ranges[i]) + 1
+	}
+	if total == 0 {
+		return 0, false
+	}
+	for {
+		ix := r.Uint32n(total)
+		for i := 0; i < len(ranges); i += 2 {
+			size := uint32(ranges[i+1]-ranges[i]) + 1
+			if ix < size {
+				c := ranges[i] + rune(ix)
+				if utf8.ValidRune(c) {
+					return c, true
+				}
+				break // surrogate, try again
+			}
+			ix -= size
+		}
+	}
+}
+
+// randomFold returns a random rune from the case folding orbit of c.
+func randomFold(r *Rand, c rune) rune {
+	n := 1
+	for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+		n++
+	}
+	for i := r.Intn(n); i > 0; i-- {
+		c = unicode.SimpleFold(c)
+	}
+	return c
+}
@@ -0,0 +1,69 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"regexp"
+	"testing"
+	"unicode/utf8"
+)
+
+var regexpTests = []string{
+	``,
+	`abc`,
+	`(?i)hello, world`,
+	`[a-z]+@[a-z]+\.(com|org|net)`,
+	`\d{3}-\d{4}`,
+	`[^a-z]*`,
+	`.{0,10}`,
+	`(?s).+`,
+	`a|b|c|(d|e)*`,
+	`x{2,5}y{3,}`,
+	`\bword\b`,
+	`^abc$`,
+	`(?m)^a$\n^b$`,
+	`[\p{Greek}\p{Han}]{5}`,
+	`0x[[:xdigit:]]{16}`,
+}
+
+func TestRegexp_Generate(t *testing.T) {
+	for _, expr := range regexpTests {
+		t.Run(expr, func(t *testing.T) {
+			re := regexp.MustCompile(`^(?:` + expr + `)$`)
+			rapid.Check(t, func(t *rapid.T) {
+				s := rapid.Uint64().Draw(t, "s").(uint64)
+				maxRepeat := rapid.IntRange(0, 20).Draw(t, "maxRepeat").(int)
+				g, err := rand.ParseRegexp(expr, maxRepeat)
+				if err != nil {
+					t.Fatalf("got unexpected parse error: %v", err)
+				}
+				str := g.Generate(rand.New(s))
+				if !utf8.ValidString(str) {
+					t.Fatalf("got invalid UTF-8 %q", str)
+				}
+				if !re.MatchString(str) {
+					t.Fatalf("got %q not matching %q", str, expr)
+				}
+			})
+		})
+	}
+}
+
+func TestRegexp_NoMatch(t *testing.T) {
+	g, err := rand.ParseRegexp(`a^b`, 10)
+	if err != nil {
+		t.Fatalf("got unexpected parse error: %v", err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("got no panic for expression matching nothing")
+		}
+	}()
+	g.Generate(rand.New(1))
+}
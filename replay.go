@@ -0,0 +1,343 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Drawer is the set of value-producing methods shared by [Rand], [Recording], [Replay],
+// [Instrumented], [Recorder], [Hedged] and [ByteSource]. Code that draws values through a Drawer can have its
+// randomness recorded, replayed, audited, hedged or provided by a fuzzer.
+type Drawer interface {
+	ExpFloat64() float64
+	Float64() float64
+	Int() int
+	Int63() int64
+	Int63n(n int64) int64
+	Intn(n int) int
+	NormFloat64() float64
+	Uint32() uint32
+	Uint32n(n uint32) uint32
+	Uint64() uint64
+	Uint64n(n uint64) uint64
+}
+
+type drawOp byte
+
+const (
+	opExpFloat64 drawOp = iota + 1
+	opFloat64
+	opInt
+	opInt63
+	opInt63n
+	opIntn
+	opNormFloat64
+	opUint32
+	opUint32n
+	opUint64
+	opUint64n
+	opMax
+)
+
+var opNames = [opMax]string{"", "ExpFloat64", "Float64", "Int", "Int63", "Int63n", "Intn", "NormFloat64", "Uint32", "Uint32n", "Uint64", "Uint64n"}
+
+type drawKey struct {
+	op  drawOp
+	arg uint64
+}
+
+type drawEntry struct {
+	drawKey
+	val uint64
+}
+
+// A DrawLog is a log of values drawn through a [Recording].
+type DrawLog struct {
+	entries []drawEntry
+}
+
+// Len returns the number of values in the log.
+func (l *DrawLog) Len() int {
+	return len(l.entries)
+}
+
+// MarshalBinary returns a compact binary representation of the log.
+func (l *DrawLog) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(l.entries)*4)
+	var buf [1 + 2*binary.MaxVarintLen64]byte
+	for _, e := range l.entries {
+		buf[0] = byte(e.op)
+		n := 1 + binary.PutUvarint(buf[1:], e.arg)
+		n += binary.PutUvarint(buf[n:], e.val)
+		data = append(data, buf[:n]...)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary sets the log to the one represented in data.
+func (l *DrawLog) UnmarshalBinary(data []byte) error {
+	var entries []drawEntry
+	for len(data) > 0 {
+		op := drawOp(data[0])
+		if op == 0 || op >= opMax {
+			return fmt.Errorf("rand: invalid draw log operation %d", op)
+		}
+		arg, n := binary.Uvarint(data[1:])
+		if n <= 0 {
+			return io.ErrUnexpectedEOF
+		}
+		data = data[1+n:]
+		val, n := binary.Uvarint(data)
+		if n <= 0 {
+			return io.ErrUnexpectedEOF
+		}
+		data = data[n:]
+		entries = append(entries, drawEntry{drawKey{op, arg}, val})
+	}
+	l.entries = entries
+	return nil
+}
+
+// A Recording wraps a [Rand] and records every value drawn through it to a [DrawLog].
+type Recording struct {
+	r   *Rand
+	log DrawLog
+}
+
+// NewRecording returns a Recording of values drawn from r.
+func NewRecording(r *Rand) *Recording {
+	return &Recording{r: r}
+}
+
+// Log returns the log of values drawn so far.
+func (rec *Recording) Log() *DrawLog {
+	return &rec.log
+}
+
+func (rec *Recording) record(op drawOp, arg uint64, val uint64) {
+	rec.log.entries = append(rec.log.entries, drawEntry{drawKey{op, arg}, val})
+}
+
+// ExpFloat64 calls [Rand.ExpFloat64] and records the result.
+func (rec *Recording) ExpFloat64() float64 {
+	v := rec.r.ExpFloat64()
+	rec.record(opExpFloat64, 0, math.Float64bits(v))
+	return v
+}
+
+// Float64 calls [Rand.Float64] and records the result.
+func (rec *Recording) Float64() float64 {
+	v := rec.r.Float64()
+	rec.record(opFloat64, 0, math.Float64bits(v))
+	return v
+}
+
+// Int calls [Rand.Int] and records the result.
+func (rec *Recording) Int() int {
+	v := rec.r.Int()
+	rec.record(opInt, 0, uint64(v))
+	return v
+}
+
+// Int63 calls [Rand.Int63] and records the result.
+func (rec *Recording) Int63() int64 {
+	v := rec.r.Int63()
+	rec.record(opInt63, 0, uint64(v))
+	return v
+}
+
+// Int63n calls [Rand.Int63n] and records the result.
+func (rec *Recording) Int63n(n int64) int64 {
+	v := rec.r.Int63n(n)
+	rec.record(opInt63n, uint64(n), uint64(v))
+	return v
+}
+
+// Intn calls [Rand.Intn] and records the result.
+func (rec *Recording) Intn(n int) int {
+	v := rec.r.Intn(n)
+	rec.record(opIntn, uint64(n), uint64(v))
+	return v
+}
+
+// NormFloat64 calls [Rand.NormFloat64] and records the result.
+func (rec *Recording) NormFloat64() float64 {
+	v := rec.r.NormFloat64()
+	rec.record(opNormFloat64, 0, math.Float64bits(v))
+	return v
+}
+
+// Uint32 calls [Rand.Uint32] and records the result.
+func (rec *Recording) Uint32() uint32 {
+	v := rec.r.Uint32()
+	rec.record(opUint32, 0, uint64(v))
+	return v
+}
+
+// Uint32n calls [Rand.Uint32n] and records the result.
+func (rec *Recording) Uint32n(n uint32) uint32 {
+	v := rec.r.Uint32n(n)
+	rec.record(opUint32n, uint64(n), uint64(v))
+	return v
+}
+
+// Uint64 calls [Rand.Uint64] and records the result.
+func (rec *Recording) Uint64() uint64 {
+	v := rec.r.Uint64()
+	rec.record(opUint64, 0, v)
+	return v
+}
+
+// Uint64n calls [Rand.Uint64n] and records the result.
+func (rec *Recording) Uint64n(n uint64) uint64 {
+	v := rec.r.Uint64n(n)
+	rec.record(opUint64n, n, v)
+	return v
+}
+
+// A Replay feeds values from a [DrawLog] back to the code that draws them.
+//
+// Values are matched by method and argument, not by global position in the log:
+// each call gets the next not yet replayed value recorded for the same method and argument.
+// This way, a run can be replayed exactly even if a code change altered the relative order
+// of draws of different kinds. Draws that have no matching value left in the log
+// are served by a fallback generator, and reported by [Replay.Err].
+type Replay struct {
+	queues   map[drawKey][]uint64
+	fallback *Rand
+	left     int
+	err      error
+}
+
+// NewReplay returns a Replay of log, using fallback for draws missing from the log.
+// NewReplay panics if fallback is nil.
+func NewReplay(log *DrawLog, fallback *Rand) *Replay {
+	if fallback == nil {
+		panic("invalid argument to NewReplay")
+	}
+	queues := map[drawKey][]uint64{}
+	for _, e := range log.entries {
+		queues[e.drawKey] = append(queues[e.drawKey], e.val)
+	}
+	return &Replay{queues: queues, fallback: fallback, left: len(log.entries)}
+}
+
+// Err returns an error describing the first draw that did not match the log, or nil if all draws matched.
+func (rp *Replay) Err() error {
+	return rp.err
+}
+
+// Remaining returns the number of logged values that were not replayed yet.
+func (rp *Replay) Remaining() int {
+	return rp.left
+}
+
+func (rp *Replay) next(op drawOp, arg uint64) (uint64, bool) {
+	k := drawKey{op, arg}
+	q := rp.queues[k]
+	if len(q) == 0 {
+		if rp.err == nil {
+			rp.err = fmt.Errorf("rand: replay mismatch: no logged value for %s(%d)", opNames[op], arg)
+		}
+		return 0, false
+	}
+	rp.queues[k] = q[1:]
+	rp.left--
+	return q[0], true
+}
+
+// ExpFloat64 replays the next value logged for [Rand.ExpFloat64].
+func (rp *Replay) ExpFloat64() float64 {
+	if v, ok := rp.next(opExpFloat64, 0); ok {
+		return math.Float64frombits(v)
+	}
+	return rp.fallback.ExpFloat64()
+}
+
+// Float64 replays the next value logged for [Rand.Float64].
+func (rp *Replay) Float64() float64 {
+	if v, ok := rp.next(opFloat64, 0); ok {
+		return math.Float64frombits(v)
+	}
+	return rp.fallback.Float64()
+}
+
+// Int replays the next value logged for [Rand.Int].
+func (rp *Replay) Int() int {
+	if v, ok := rp.next(opInt, 0); ok {
+		return int(v)
+	}
+	return rp.fallback.Int()
+}
+
+// Int63 replays the next value logged for [Rand.Int63].
+func (rp *Replay) Int63() int64 {
+	if v, ok := rp.next(opInt63, 0); ok {
+		return int64(v)
+	}
+	return rp.fallback.Int63()
+}
+
+// Int63n replays the next value logged for [Rand.Int63n] with the same n.
+func (rp *Replay) Int63n(n int64) int64 {
+	if v, ok := rp.next(opInt63n, uint64(n)); ok {
+		return int64(v)
+	}
+	return rp.fallback.Int63n(n)
+}
+
+// Intn replays the next value logged for [Rand.Intn] with the same n.
+func (rp *Replay) Intn(n int) int {
+	if v, ok := rp.next(opIntn, uint64(n)); ok {
+		return int(v)
+	}
+	return rp.fallback.Intn(n)
+}
+
+// NormFloat64 replays the next value logged for [Rand.NormFloat64].
+func (rp *Replay) NormFloat64() float64 {
+	if v, ok := rp.next(opNormFloat64, 0); ok {
+		return math.Float64frombits(v)
+	}
+	return rp.fallback.NormFloat64()
+}
+
+// Uint32 replays the next value logged for [Rand.Uint32].
+func (rp *Replay) Uint32() uint32 {
+	if v, ok := rp.next(opUint32, 0); ok {
+		return uint32(v)
+	}
+	return rp.fallback.Uint32()
+}
+
+// Uint32n replays the next value logged for [Rand.Uint32n] with the same n.
+func (rp *Replay) Uint32n(n uint32) uint32 {
+	if v, ok := rp.next(opUint32n, uint64(n)); ok {
+		return uint32(v)
+	}
+	return rp.fallback.Uint32n(n)
+}
+
+// Uint64 replays the next value logged for [Rand.Uint64].
+func (rp *Replay) Uint64() uint64 {
+	if v, ok := rp.next(opUint64, 0); ok {
+		return v
+	}
+	return rp.fallback.Uint64()
+}
+
+// Uint64n replays the next value logged for [Rand.Uint64n] with the same n.
+func (rp *Replay) Uint64n(n uint64) uint64 {
+	if v, ok := rp.next(opUint64n, n); ok {
+		return v
+	}
+	return rp.fallback.Uint64n(n)
+}
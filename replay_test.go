@@ -0,0 +1,83 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"reflect"
+	"testing"
+)
+
+func drawAll(d rand.Drawer, order []int) []interface{} {
+	var out []interface{}
+	for _, op := range order {
+		switch op {
+		case 0:
+			out = append(out, d.ExpFloat64())
+		case 1:
+			out = append(out, d.Float64())
+		case 2:
+			out = append(out, d.Int())
+		case 3:
+			out = append(out, d.Int63())
+		case 4:
+			out = append(out, d.Int63n(small))
+		case 5:
+			out = append(out, d.Intn(tiny))
+		case 6:
+			out = append(out, d.NormFloat64())
+		case 7:
+			out = append(out, d.Uint32())
+		case 8:
+			out = append(out, d.Uint32n(small))
+		case 9:
+			out = append(out, d.Uint64())
+		case 10:
+			out = append(out, d.Uint64n(1<<40))
+		}
+	}
+	return out
+}
+
+func TestReplay(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		order := rapid.SliceOf(rapid.IntRange(0, 10)).Draw(t, "order").([]int)
+		rec := rand.NewRecording(rand.New(s))
+		want := drawAll(rec, order)
+		data, err := rec.Log().MarshalBinary()
+		if err != nil {
+			t.Fatalf("got unexpected marshal error: %v", err)
+		}
+		var log rand.DrawLog
+		if err := log.UnmarshalBinary(data); err != nil {
+			t.Fatalf("got unexpected unmarshal error: %v", err)
+		}
+		rp := rand.NewReplay(&log, rand.New(s+1))
+		got := drawAll(rp, order)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v instead of %v", got, want)
+		}
+		if rp.Err() != nil || rp.Remaining() != 0 {
+			t.Fatalf("got error %v with %v values remaining", rp.Err(), rp.Remaining())
+		}
+	})
+}
+
+func TestReplay_Reordered(t *testing.T) {
+	rec := rand.NewRecording(rand.New(1))
+	i, f := rec.Intn(10), rec.Float64()
+	rp := rand.NewReplay(rec.Log(), rand.New(2))
+	if f2, i2 := rp.Float64(), rp.Intn(10); f2 != f || i2 != i {
+		t.Fatalf("got (%v, %v) instead of (%v, %v)", f2, i2, f, i)
+	}
+	rp.Intn(10)
+	if rp.Err() == nil {
+		t.Fatalf("got no mismatch error for a draw missing from the log")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// Quaternion returns, as a unit quaternion {w, x, y, z}, a rotation uniformly distributed
+// over the 3D rotation group SO(3).
+func (r *Rand) Quaternion() [4]float64 {
+	// "Uniform Random Rotations" by Ken Shoemake, Graphics Gems III
+	u1, u2, u3 := r.Float64(), r.Float64(), r.Float64()
+	r1, r2 := math.Sqrt(1-u1), math.Sqrt(u1)
+	s1, c1 := math.Sincos(2 * math.Pi * u2)
+	s2, c2 := math.Sincos(2 * math.Pi * u3)
+	return [4]float64{r2 * c2, r1 * s1, r1 * c1, r2 * s2}
+}
+
+// RotationMatrix returns, as a 3×3 row-major orthogonal matrix, a rotation uniformly distributed
+// over the 3D rotation group SO(3).
+func (r *Rand) RotationMatrix() [3][3]float64 {
+	q := r.Quaternion()
+	w, x, y, z := q[0], q[1], q[2], q[3]
+	return [3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w)},
+		{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w)},
+		{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y)},
+	}
+}
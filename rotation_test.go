@@ -0,0 +1,69 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Quaternion(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		r := rand.New(s)
+		q := r.Quaternion()
+		if n := q[0]*q[0] + q[1]*q[1] + q[2]*q[2] + q[3]*q[3]; math.Abs(n-1) > 1e-12 {
+			t.Fatalf("got %v with squared norm %v", q, n)
+		}
+	})
+}
+
+func TestRand_RotationMatrix(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		r := rand.New(s)
+		m := r.RotationMatrix()
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				var dot float64
+				for k := 0; k < 3; k++ {
+					dot += m[i][k] * m[j][k]
+				}
+				want := 0.0
+				if i == j {
+					want = 1
+				}
+				if math.Abs(dot-want) > 1e-12 {
+					t.Fatalf("got non-orthogonal matrix %v", m)
+				}
+			}
+		}
+		det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+			m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+			m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+		if math.Abs(det-1) > 1e-12 {
+			t.Fatalf("got matrix %v with determinant %v", m, det)
+		}
+	})
+}
+
+func TestRand_RotationMatrixUniform(t *testing.T) {
+	r := rand.New(1)
+	// uniformly distributed rotation maps a fixed vector to a uniformly distributed point on a sphere,
+	// each coordinate of which is uniform by Archimedes' hat-box theorem
+	samples := make([]float64, 10000)
+	for i := range samples {
+		m := r.RotationMatrix()
+		samples[i] = m[i%3][2]
+	}
+	if err := randtest.CheckUniform(samples, -1, 1, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
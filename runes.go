@@ -0,0 +1,142 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rune returns a pseudo-random valid code point uniformly distributed over the ones in table,
+// or over all valid code points if table is nil. It panics if table contains no valid code points.
+func (r *Rand) Rune(table *unicode.RangeTable) rune {
+	if table == nil {
+		return randomRune(r)
+	}
+	return r.tableRune(table, tableSize(table))
+}
+
+// RuneString returns a string of n pseudo-random valid code points uniformly distributed
+// over the ones in table, or over all valid code points if table is nil.
+// It panics if n < 0 or table contains no valid code points.
+func (r *Rand) RuneString(n int, table *unicode.RangeTable) string {
+	if n < 0 {
+		panic("invalid argument to RuneString")
+	}
+	var sb strings.Builder
+	if table == nil {
+		for i := 0; i < n; i++ {
+			sb.WriteRune(randomRune(r))
+		}
+		return sb.String()
+	}
+	size := tableSize(table)
+	for i := 0; i < n; i++ {
+		sb.WriteRune(r.tableRune(table, size))
+	}
+	return sb.String()
+}
+
+// UTF8String returns a valid UTF-8 string of n pseudo-random code points, with lengths of their
+// encodings uniformly distributed over 1, 2, 3 and 4 bytes. Unlike uniformly distributed code points
+// (almost all of which are encoded with 4 bytes), this exercises all kinds of multi-byte sequences,
+// including ones from the astral planes. It panics if n < 0.
+func (r *Rand) UTF8String(n int) string {
+	if n < 0 {
+		panic("invalid argument to UTF8String")
+	}
+	var sb strings.Builder
+	sb.Grow(n * 5 / 2)
+	for i := 0; i < n; i++ {
+		var c rune
+		switch r.Bits(2) {
+		case 0:
+			c = rune(r.Uint32n(utf8.RuneSelf))
+		case 1:
+			c = 0x80 + rune(r.Uint32n(0x800-0x80))
+		case 2:
+			c = 0x800 + rune(r.Uint32n(0x10000-0x800-(surrogateMax-surrogateMin+1)))
+			if c >= surrogateMin {
+				c += surrogateMax - surrogateMin + 1
+			}
+		default:
+			c = 0x10000 + rune(r.Uint32n(unicode.MaxRune+1-0x10000))
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// tableSize returns the number of valid code points in table.
+func tableSize(table *unicode.RangeTable) uint32 {
+	var size uint32
+	for _, rg := range table.R16 {
+		n, _, _ := rangeValid(uint32(rg.Lo), uint32(rg.Hi), uint32(rg.Stride))
+		size += n
+	}
+	for _, rg := range table.R32 {
+		n, _, _ := rangeValid(rg.Lo, rg.Hi, rg.Stride)
+		size += n
+	}
+	return size
+}
+
+// tableRune returns the code point of table with index ix in [0, size) among the valid ones,
+// skipping over the surrogates instead of rejecting them.
+func (r *Rand) tableRune(table *unicode.RangeTable, size uint32) rune {
+	if size == 0 {
+		panic("rand: no valid code points in the table")
+	}
+	ix := r.Uint32n(size)
+	for _, rg := range table.R16 {
+		if c, ok := rangeAt(uint32(rg.Lo), uint32(rg.Hi), uint32(rg.Stride), &ix); ok {
+			return c
+		}
+	}
+	for _, rg := range table.R32 {
+		if c, ok := rangeAt(rg.Lo, rg.Hi, rg.Stride, &ix); ok {
+			return c
+		}
+	}
+	panic("unreachable")
+}
+
+// rangeAt returns the valid code point of the range with index *ix, or decreases *ix
+// by the number of valid code points in the range if there is no such point.
+func rangeAt(lo uint32, hi uint32, stride uint32, ix *uint32) (rune, bool) {
+	n, s0, s1 := rangeValid(lo, hi, stride)
+	if *ix >= n {
+		*ix -= n
+		return 0, false
+	}
+	k := *ix
+	if k >= s0 {
+		k += s1 - s0 // past the surrogate gap
+	}
+	return rune(lo + k*stride), true
+}
+
+// rangeValid returns the number of valid code points among lo, lo+stride, ..., hi,
+// and the indices [s0, s1) of the surrogates among them.
+func rangeValid(lo uint32, hi uint32, stride uint32) (n uint32, s0 uint32, s1 uint32) {
+	total := pointsBelow(lo, hi, stride, unicode.MaxRune+1)
+	s0 = pointsBelow(lo, hi, stride, surrogateMin)
+	s1 = pointsBelow(lo, hi, stride, surrogateMax+1)
+	return total - (s1 - s0), s0, s1
+}
+
+// pointsBelow returns the number of points among lo, lo+stride, ..., hi that are less than x.
+func pointsBelow(lo uint32, hi uint32, stride uint32, x uint32) uint32 {
+	if x <= lo {
+		return 0
+	}
+	if hi >= x {
+		hi = x - 1
+	}
+	return (hi-lo)/stride + 1
+}
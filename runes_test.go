@@ -0,0 +1,104 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+var runeTables = []*unicode.RangeTable{nil, unicode.Latin, unicode.Han, unicode.Greek, unicode.Nd, unicode.C}
+
+func TestRand_RuneString(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, tiny).Draw(t, "n").(int)
+		table := rapid.SampledFrom(runeTables).Draw(t, "table").(*unicode.RangeTable)
+		r := rand.New(s)
+		str := r.RuneString(n, table)
+		if !utf8.ValidString(str) {
+			t.Fatalf("got invalid UTF-8 %q", str)
+		}
+		if c := utf8.RuneCountInString(str); c != n {
+			t.Fatalf("got %v runes instead of %v", c, n)
+		}
+		for _, c := range str {
+			if table != nil && !unicode.Is(table, c) {
+				t.Fatalf("got %q outside of the table", c)
+			}
+		}
+	})
+}
+
+func TestRand_UTF8String(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		str := r.UTF8String(n)
+		if !utf8.ValidString(str) {
+			t.Fatalf("got invalid UTF-8 %q", str)
+		}
+		if c := utf8.RuneCountInString(str); c != n {
+			t.Fatalf("got %v runes instead of %v", c, n)
+		}
+	})
+}
+
+func TestRand_UTF8StringLengths(t *testing.T) {
+	r := rand.New(1)
+	var counts [5]int
+	for _, c := range r.UTF8String(10000) {
+		counts[utf8.RuneLen(c)]++
+	}
+	for l := 1; l <= 4; l++ {
+		if counts[l] < 2000 {
+			t.Errorf("got only %v runes of length %v", counts[l], l)
+		}
+	}
+}
+
+func TestRand_RuneSurrogates(t *testing.T) {
+	r := rand.New(1)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Rune did not panic for a table of surrogates")
+		}
+	}()
+	r.Rune(unicode.Cs)
+}
+
+func TestRand_RuneMostlySurrogates(t *testing.T) {
+	table := &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x41, Hi: 0x41, Stride: 1}, {Lo: 0xD800, Hi: 0xDFFF, Stride: 1}}}
+	r := rand.New(1)
+	for i := 0; i < small; i++ {
+		if c := r.Rune(table); c != 'A' {
+			t.Fatalf("got %q instead of the only valid code point", c)
+		}
+	}
+}
+
+func TestRand_RuneStrideOverSurrogates(t *testing.T) {
+	// 0xD000, 0xD400, 0xE000 and 0xE400 are valid, 0xD800 and 0xDC00 are surrogates
+	table := &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xD000, Hi: 0xE400, Stride: 0x400}}}
+	r := rand.New(1)
+	counts := map[rune]int{}
+	for i := 0; i < small; i++ {
+		counts[r.Rune(table)]++
+	}
+	for _, c := range []rune{0xD000, 0xD400, 0xE000, 0xE400} {
+		if counts[c] < small/8 {
+			t.Errorf("got %v of %v draws of %U", counts[c], small, c)
+		}
+	}
+	if len(counts) != 4 {
+		t.Errorf("got code points %v", counts)
+	}
+}
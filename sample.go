@@ -0,0 +1,42 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+// SampleWithReplacement returns k elements of s chosen uniformly and independently, as in bootstrap resampling;
+// the same element can be chosen more than once. It allocates only the returned slice.
+// It panics if k < 0, or if s is empty and k > 0.
+//
+// When r is nil, SampleWithReplacement uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func SampleWithReplacement[T any](r *Rand, s []T, k int) []T {
+	if k < 0 || (len(s) == 0 && k > 0) {
+		panic("invalid argument to SampleWithReplacement")
+	}
+	res := make([]T, k)
+	for i := range res {
+		res[i] = s[randIntn(r, len(s))]
+	}
+	return res
+}
+
+// SampleIndicesWithReplacement is like [SampleWithReplacement], but returns k indices in [0, n)
+// instead of elements. It panics if k < 0, or if n <= 0 and k > 0.
+//
+// When r is nil, SampleIndicesWithReplacement uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func SampleIndicesWithReplacement(r *Rand, n int, k int) []int {
+	if k < 0 || (n <= 0 && k > 0) {
+		panic("invalid argument to SampleIndicesWithReplacement")
+	}
+	res := make([]int, k)
+	for i := range res {
+		res[i] = randIntn(r, n)
+	}
+	return res
+}
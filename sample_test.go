@@ -0,0 +1,44 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestSampleWithReplacement(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, small).Draw(t, "n").(int)
+		k := rapid.IntRange(0, small).Draw(t, "k").(int)
+		items := make([]string, n)
+		for i := range items {
+			items[i] = string(rune('a' + i%26))
+		}
+		got := rand.SampleWithReplacement(rand.New(s), items, k)
+		ixs := rand.SampleIndicesWithReplacement(rand.New(s), n, k)
+		if len(got) != k || len(ixs) != k {
+			t.Fatalf("got %v elements and %v indices instead of %v", len(got), len(ixs), k)
+		}
+		for i, ix := range ixs {
+			if ix < 0 || ix >= n || got[i] != items[ix] {
+				t.Fatalf("element %q does not match index %v", got[i], ix)
+			}
+		}
+	})
+}
+
+func TestSampleIndicesWithReplacement_Uniform(t *testing.T) {
+	r := rand.New(1)
+	checkUniformOutcomes(t, 7, func() string {
+		return string(rune('0' + rand.SampleIndicesWithReplacement(r, 7, 1)[0]))
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// A SeedSequence is a node in a tree of seeds, inspired by NumPy's SeedSequence.
+// It is identified by the root entropy and the path of child indexes from the root,
+// and can be reconstructed from them on a different machine with [NewSeedSequence].
+// Each node spawns an unlimited number of children, and provides seeds for [New]
+// that are distinct from those of any other node of the same tree (barring 64-bit hash collisions).
+//
+// Unlike [Rand.Derive], SeedSequence does not depend on the state of any generator.
+// SeedSequence is not safe for concurrent use.
+type SeedSequence struct {
+	entropy uint64
+	path    []uint64
+	spawned uint64
+}
+
+// NewSeedSequence returns a SeedSequence for the root entropy and the path of child indexes.
+// NewSeedSequence(entropy) returns the root of the tree.
+func NewSeedSequence(entropy uint64, path ...uint64) *SeedSequence {
+	return &SeedSequence{entropy: entropy, path: append([]uint64(nil), path...)}
+}
+
+// Entropy returns the root entropy of s.
+func (s *SeedSequence) Entropy() uint64 {
+	return s.entropy
+}
+
+// Path returns the path of child indexes from the root to s.
+func (s *SeedSequence) Path() []uint64 {
+	return append([]uint64(nil), s.path...)
+}
+
+// Spawn returns n children of s not returned by the previous calls of Spawn.
+// It panics if n < 0.
+func (s *SeedSequence) Spawn(n int) []*SeedSequence {
+	if n < 0 {
+		panic("invalid argument to Spawn")
+	}
+	children := make([]*SeedSequence, n)
+	for i := range children {
+		children[i] = s.Child(s.spawned)
+		s.spawned++
+	}
+	return children
+}
+
+// Child returns the i-th child of s. It does not affect the children returned by [SeedSequence.Spawn].
+func (s *SeedSequence) Child(i uint64) *SeedSequence {
+	path := make([]uint64, len(s.path)+1)
+	copy(path, s.path)
+	path[len(s.path)] = i
+	return &SeedSequence{entropy: s.entropy, path: path}
+}
+
+// Seed returns the seed of s, to be used as New(seed[0], seed[1], seed[2]).
+func (s *SeedSequence) Seed() [3]uint64 {
+	// sponge-like absorption of the entropy, path length and path into the 64-bit state;
+	// path length is absorbed first to make paths of different lengths hash differently
+	h := mix64(s.entropy ^ 0x243f6a8885a308d3)
+	h = mix64(h ^ uint64(len(s.path)))
+	for _, p := range s.path {
+		h = mix64(h ^ mix64(p+0x9e3779b97f4a7c15))
+	}
+	return [3]uint64{mix64(h + 1), mix64(h + 2), mix64(h + 3)}
+}
+
+// New returns a generator seeded with the seed of s.
+func (s *SeedSequence) New() *Rand {
+	seed := s.Seed()
+	return New(seed[0], seed[1], seed[2])
+}
+
+// mix64 is a bijective 64-bit mixing function (finalizer of SplitMix64).
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestSeedSequence_Reconstruct(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		entropy := rapid.Uint64().Draw(t, "entropy").(uint64)
+		depth := rapid.IntRange(0, 5).Draw(t, "depth").(int)
+		s := rand.NewSeedSequence(entropy)
+		for i := 0; i < depth; i++ {
+			n := rapid.IntRange(1, 5).Draw(t, "n").(int)
+			s = s.Spawn(n)[n-1]
+		}
+		s2 := rand.NewSeedSequence(s.Entropy(), s.Path()...)
+		if s.Seed() != s2.Seed() {
+			t.Fatalf("reconstructed sequence %v has different seed", s.Path())
+		}
+		if s.New().Uint64() != s2.New().Uint64() {
+			t.Fatalf("reconstructed sequence %v has different generator", s.Path())
+		}
+	})
+}
+
+func TestSeedSequence_Distinct(t *testing.T) {
+	seen := map[[3]uint64][]uint64{}
+	var walk func(s *rand.SeedSequence, depth int)
+	walk = func(s *rand.SeedSequence, depth int) {
+		if p, ok := seen[s.Seed()]; ok {
+			t.Fatalf("sequences %v and %v have the same seed", p, s.Path())
+		}
+		seen[s.Seed()] = s.Path()
+		if depth > 0 {
+			for _, c := range s.Spawn(8) {
+				walk(c, depth-1)
+			}
+		}
+	}
+	walk(rand.NewSeedSequence(0), 4)
+	walk(rand.NewSeedSequence(1), 4)
+	if got, want := rand.NewSeedSequence(0).Child(3).Seed(), rand.NewSeedSequence(0, 3).Seed(); got != want {
+		t.Fatalf("got seed %v instead of %v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// A Segments generates points uniformly distributed over a 1-D domain made of segments
+// of given lengths, choosing each segment with probability proportional to its length.
+// Segments is immutable and safe for concurrent use, as long as each goroutine uses its own [Rand].
+type Segments struct {
+	lengths []float64
+	table   alias
+}
+
+// NewSegments returns a Segments for segments of the given lengths. NewSegments copies the lengths.
+// It panics if lengths are empty, contain negative, NaN or infinite values, or sum to zero.
+func NewSegments(lengths []float64) *Segments {
+	table, ok := newAlias(lengths)
+	if !ok {
+		panic("invalid argument to NewSegments")
+	}
+	return &Segments{lengths: append([]float64(nil), lengths...), table: table}
+}
+
+// Point returns the index of a segment and an offset into it, in the half-open interval [0, length).
+//
+// When r is nil, Point uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func (s *Segments) Point(r *Rand) (segment int, offset float64) {
+	segment = s.table.next(r)
+	return segment, randFloat64(r) * s.lengths[segment]
+}
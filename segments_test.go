@@ -0,0 +1,45 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestSegments_InRange(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lengths := rapid.SliceOfN(rapid.Float64Range(0, 1e6), 1, tiny).Draw(t, "lengths").([]float64)
+		lengths[0] += 1 // positive sum
+		r := rand.New(s)
+		seg := rand.NewSegments(lengths)
+		for i := 0; i < 16; i++ {
+			ix, off := seg.Point(r)
+			if ix < 0 || ix >= len(lengths) || lengths[ix] == 0 || off < 0 || off >= lengths[ix] {
+				t.Fatalf("got offset %v in segment %v of %v", off, ix, lengths)
+			}
+		}
+	})
+}
+
+func TestSegments_Uniform(t *testing.T) {
+	lengths := []float64{0.5, 0, 2, 1.5}
+	starts := []float64{0, 0.5, 0.5, 2.5}
+	seg := rand.NewSegments(lengths)
+	r := rand.New(1)
+	samples := make([]float64, 10000)
+	for i := range samples {
+		ix, off := seg.Point(r)
+		samples[i] = starts[ix] + off
+	}
+	if err := randtest.CheckUniform(samples, 0, 4, 1e-4); err != nil {
+		t.Error(err)
+	}
+}
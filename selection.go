@@ -0,0 +1,118 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// RouletteSelect returns an index of fitness chosen with probability proportional to its value
+// (fitness-proportionate selection). It panics if fitness is empty, contains negative, NaN
+// or infinite values, or sums to zero. When selecting many times from the same population,
+// a precomputed alias table is faster than RouletteSelect, which takes O(len(fitness)) time per call.
+//
+// When r is nil, RouletteSelect uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func RouletteSelect(r *Rand, fitness []float64) int {
+	i := weightedIndex(r, fitness)
+	if i < 0 {
+		panic("invalid argument to RouletteSelect")
+	}
+	return i
+}
+
+// weightedIndex returns an index of weights chosen with probability proportional to its weight,
+// or -1 if the weights are invalid.
+func weightedIndex(r *Rand, weights []float64) int {
+	var sum float64
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return -1
+		}
+		sum += w
+	}
+	if !(sum > 0) || math.IsInf(sum, 1) {
+		return -1
+	}
+	u := randFloat64(r) * sum
+	last := 0
+	for i, w := range weights {
+		if u < w {
+			return i
+		}
+		u -= w
+		if w > 0 {
+			last = i
+		}
+	}
+	return last // rounding errors
+}
+
+// TournamentSelect returns the index of the fittest of k individuals chosen uniformly
+// with replacement. Larger k increases the selection pressure; ties are resolved in favour of
+// the individual drawn first. It panics if fitness is empty or k < 1.
+//
+// When r is nil, TournamentSelect uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func TournamentSelect(r *Rand, fitness []float64, k int) int {
+	if len(fitness) == 0 || k < 1 {
+		panic("invalid argument to TournamentSelect")
+	}
+	best := randIntn(r, len(fitness))
+	for i := 1; i < k; i++ {
+		j := randIntn(r, len(fitness))
+		if fitness[j] > fitness[best] {
+			best = j
+		}
+	}
+	return best
+}
+
+// OnePointCrossover returns a crossover point for parents of length n, uniformly distributed
+// in the half-open interval [1, n): the child takes genes [0, point) from one parent and the rest
+// from the other. It panics if n < 2.
+//
+// When r is nil, OnePointCrossover uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func OnePointCrossover(r *Rand, n int) int {
+	if n < 2 {
+		panic("invalid argument to OnePointCrossover")
+	}
+	return 1 + randIntn(r, n-1)
+}
+
+// UniformCrossover fills mask with independent fair coin flips: the child takes gene i
+// from the second parent when mask[i] is true, and from the first one otherwise.
+//
+// When r is nil, UniformCrossover uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func UniformCrossover(r *Rand, mask []bool) {
+	for i := 0; i < len(mask); i += 64 {
+		var v uint64
+		if r == nil {
+			v = Uint64()
+		} else {
+			v = r.Uint64()
+		}
+		for j := i; j < len(mask) && j < i+64; j++ {
+			mask[j] = v&1 != 0
+			v >>= 1
+		}
+	}
+}
+
+func randFloat64(r *Rand) float64 {
+	if r == nil {
+		return Float64()
+	}
+	return r.Float64()
+}
+
+func randIntn(r *Rand, n int) int {
+	if r == nil {
+		return Intn(n)
+	}
+	return r.Intn(n)
+}
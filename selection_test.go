@@ -0,0 +1,70 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRouletteSelect(t *testing.T) {
+	fitness := []float64{1, 0, 3, 6}
+	r := rand.New(1)
+	observed := make([]int, len(fitness))
+	for i := 0; i < 10000; i++ {
+		observed[rand.RouletteSelect(r, fitness)]++
+	}
+	if observed[1] != 0 {
+		t.Fatalf("selected zero-fitness individual %v times", observed[1])
+	}
+	expected := []float64{1000, 0, 3000, 6000}
+	if _, p := randtest.ChiSquared([]int{observed[0], observed[2], observed[3]}, []float64{expected[0], expected[2], expected[3]}); p < 1e-4 {
+		t.Errorf("got p-value %v for %v", p, observed)
+	}
+}
+
+func TestTournamentSelect(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		fitness := rapid.SliceOfN(rapid.Float64Range(-10, 10), 1, tiny).Draw(t, "fitness").([]float64)
+		r := rand.New(s)
+		i := rand.TournamentSelect(r, fitness, 1000)
+		for _, f := range fitness {
+			if f > fitness[i] && len(fitness) <= 10 {
+				t.Fatalf("tournament of size 1000 selected %v instead of %v", fitness[i], f)
+			}
+		}
+	})
+}
+
+func TestOnePointCrossover(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(2, small).Draw(t, "n").(int)
+		if p := rand.OnePointCrossover(rand.New(s), n); p < 1 || p >= n {
+			t.Fatalf("got crossover point %v outside of [1, %v)", p, n)
+		}
+	})
+}
+
+func TestUniformCrossover(t *testing.T) {
+	mask := make([]bool, 10000)
+	rand.UniformCrossover(rand.New(1), mask)
+	observed := make([]int, 2)
+	for _, m := range mask {
+		if m {
+			observed[1]++
+		} else {
+			observed[0]++
+		}
+	}
+	if _, p := randtest.ChiSquared(observed, []float64{5000, 5000}); p < 1e-4 {
+		t.Errorf("got p-value %v for %v", p, observed)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/gozelle/rand/internal/stats"
+	"math"
+	"sort"
+)
+
+const (
+	selfTestWords    = 1 << 14
+	selfTestAlpha    = 1e-8 // false positive rate of a correct source per test
+	birthdayDaysBits = 24
+	birthdayDays     = 1 << birthdayDaysBits
+	birthdayCount    = 1 << 10
+	birthdayRepeats  = 16
+	birthdayExpected = float64(birthdayCount) * birthdayCount * birthdayCount / (4 * birthdayDays) * birthdayRepeats
+)
+
+// Source64 is a source of uniformly distributed pseudo-random 64-bit values.
+// It exists only to allow [SelfTest] to check sources other than [Rand].
+type Source64 interface {
+	Uint64() uint64
+}
+
+// SelfTest runs a quick battery of statistical tests (monobit, runs, chi-squared on bytes
+// and birthday spacings) against src, and returns a non-nil error describing the first failed test.
+// It consumes about 2^15 values and takes a few milliseconds, which makes it suitable
+// for startup sanity checks; passing it does not mean the source is of high quality.
+func SelfTest(src Source64) error {
+	data := make([]byte, selfTestWords*8)
+	for i := 0; i < len(data); i += 8 {
+		binary.LittleEndian.PutUint64(data[i:], src.Uint64())
+	}
+	tests := [...]struct {
+		name string
+		fn   func([]byte) float64
+	}{
+		{"monobit", stats.Monobit},
+		{"runs", stats.Runs},
+		{"byte chi-squared", selfTestBytes},
+		{"birthday spacings", func([]byte) float64 { return selfTestBirthday(src) }},
+	}
+	for _, test := range tests {
+		if p := test.fn(data); !(p >= selfTestAlpha) {
+			return fmt.Errorf("rand: self-test %v failed (p = %.3g)", test.name, p)
+		}
+	}
+	return nil
+}
+
+func selfTestBytes(data []byte) float64 {
+	observed := make([]int, 256)
+	for _, b := range data {
+		observed[b]++
+	}
+	expected := make([]float64, 256)
+	for i := range expected {
+		expected[i] = float64(len(data)) / 256
+	}
+	_, p := stats.ChiSquared(observed, expected)
+	return p
+}
+
+func selfTestBirthday(src Source64) float64 {
+	// see G. Marsaglia, "A current view of random number generators"
+	days := make([]uint64, birthdayCount)
+	dups := 0
+	for k := 0; k < birthdayRepeats; k++ {
+		for i := range days {
+			days[i] = src.Uint64() >> (64 - birthdayDaysBits)
+		}
+		sort.Sort(uint64Slice(days))
+		for i := len(days) - 1; i > 0; i-- {
+			days[i] -= days[i-1]
+		}
+		sort.Sort(uint64Slice(days))
+		for i := 1; i < len(days); i++ {
+			if days[i] == days[i-1] {
+				dups++
+			}
+		}
+	}
+	// number of duplicate spacings is asymptotically Poisson-distributed
+	z := (float64(dups) - birthdayExpected) / math.Sqrt(birthdayExpected)
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+type uint64Slice []uint64
+
+func (s uint64Slice) Len() int           { return len(s) }
+func (s uint64Slice) Less(i, j int) bool { return s[i] < s[j] }
+func (s uint64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,56 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+type constSource uint64
+
+func (s constSource) Uint64() uint64 { return uint64(s) }
+
+type counterSource uint64
+
+func (s *counterSource) Uint64() uint64 { *s++; return uint64(*s) * 0x9e3779b97f4a7c15 }
+
+type lowBitsSource struct{ r *rand.Rand }
+
+func (s lowBitsSource) Uint64() uint64 { return s.r.Uint64() &^ 1 }
+
+func TestSelfTest(t *testing.T) {
+	for _, seed := range testSeeds {
+		if err := rand.SelfTest(rand.New(uint64(seed))); err != nil {
+			t.Errorf("seed %v: %v", seed, err)
+		}
+	}
+}
+
+func TestSelfTest_Bad(t *testing.T) {
+	var counter counterSource
+	bad := []rand.Source64{
+		constSource(0),
+		constSource(0x5555555555555555),
+		&counter,
+		lowBitsSource{rand.New(1)},
+	}
+	for i, src := range bad {
+		if err := rand.SelfTest(src); err == nil {
+			t.Errorf("bad source %v passed the self-test", i)
+		} else {
+			t.Log(err)
+		}
+	}
+}
+
+func BenchmarkSelfTest(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		_ = rand.SelfTest(r)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.23
+
+package rand
+
+import "iter"
+
+// Uint64Seq returns an infinite sequence of values produced by [Rand.Uint64].
+func (r *Rand) Uint64Seq() iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		for yield(r.Uint64()) {
+		}
+	}
+}
+
+// Float64Seq returns an infinite sequence of values produced by [Rand.Float64].
+func (r *Rand) Float64Seq() iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		for yield(r.Float64()) {
+		}
+	}
+}
+
+// IntnSeq returns an infinite sequence of values produced by [Rand.Intn]. It panics if n <= 0.
+func (r *Rand) IntnSeq(n int) iter.Seq[int] {
+	if n <= 0 {
+		panic("invalid argument to IntnSeq")
+	}
+	return func(yield func(int) bool) {
+		for yield(r.Intn(n)) {
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.23
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Seqs(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, small).Draw(t, "n").(int)
+		k := rapid.IntRange(1, tiny).Draw(t, "k").(int)
+		r1 := rand.New(s)
+		r2 := rand.New(s)
+		i := 0
+		for v := range r1.Uint64Seq() {
+			if w := r2.Uint64(); v != w {
+				t.Fatalf("got %v instead of %v at %v", v, w, i)
+			}
+			if i++; i == k {
+				break
+			}
+		}
+		i = 0
+		for v := range r1.Float64Seq() {
+			if w := r2.Float64(); v != w {
+				t.Fatalf("got %v instead of %v at %v", v, w, i)
+			}
+			if i++; i == k {
+				break
+			}
+		}
+		i = 0
+		for v := range r1.IntnSeq(n) {
+			if w := r2.Intn(n); v != w {
+				t.Fatalf("got %v instead of %v at %v", v, w, i)
+			}
+			if i++; i == k {
+				break
+			}
+		}
+	})
+}
@@ -58,3 +58,12 @@ func (s *sfc64) next64() (out uint64) { // named return value lowers inlining co
 	s.a, s.b, s.c = s.b^(s.b>>11), s.c+(s.c<<3), bits.RotateLeft64(s.c, 24)+out // single assignment lowers inlining cost
 	return
 }
+
+func (s *sfc64) prev64() {
+	// inverse of next64: xorshift, multiplication by 9 (odd) and addition are all invertible
+	b := s.a ^ s.a>>11 ^ s.a>>22 ^ s.a>>33 ^ s.a>>44 ^ s.a>>55
+	c := s.b * 0x8e38e38e38e38e39 // 9⁻¹ mod 2⁶⁴
+	out := s.c - bits.RotateLeft64(c, 24)
+	s.w--
+	s.a, s.b, s.c = out-b-s.w, b, c
+}
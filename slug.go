@@ -0,0 +1,54 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "strings"
+
+// 64 words each, so that a word takes exactly 6 bits
+var (
+	slugAdjectives = [64]string{
+		"able", "amber", "ancient", "autumn", "bold", "brave", "bright", "brisk",
+		"calm", "clever", "cold", "cosmic", "crimson", "curly", "daring", "dusty",
+		"eager", "early", "fancy", "fast", "fierce", "fluffy", "frosty", "gentle",
+		"giant", "golden", "grand", "happy", "hidden", "humble", "icy", "jolly",
+		"kind", "lively", "lucky", "mellow", "misty", "modest", "noble", "odd",
+		"patient", "plain", "polite", "proud", "quiet", "rapid", "rare", "rusty",
+		"shiny", "silent", "silver", "sleepy", "smooth", "snowy", "solar", "steady",
+		"sunny", "swift", "tender", "tidy", "vivid", "wild", "windy", "witty",
+	}
+	slugNouns = [64]string{
+		"anchor", "badger", "beacon", "bison", "breeze", "brook", "canyon", "cedar",
+		"cloud", "comet", "coral", "crane", "creek", "dawn", "delta", "dune",
+		"eagle", "ember", "falcon", "fern", "field", "finch", "forest", "fox",
+		"galaxy", "glacier", "grove", "harbor", "hawk", "heron", "island", "lake",
+		"lantern", "leaf", "lynx", "maple", "meadow", "meteor", "moon", "moose",
+		"nebula", "ocean", "orbit", "otter", "owl", "panda", "pebble", "pine",
+		"planet", "pond", "quartz", "raven", "reef", "river", "robin", "sparrow",
+		"spruce", "star", "stone", "summit", "thunder", "tiger", "valley", "willow",
+	}
+)
+
+// Slug returns a pseudo-random human-readable name like "brave-otter-0421", made of words-1 adjectives,
+// a noun and a 4-digit number, separated by hyphens. Slug panics if words < 1.
+func (r *Rand) Slug(words int) string {
+	if words < 1 {
+		panic("invalid argument to Slug")
+	}
+	var b strings.Builder
+	for i := 0; i < words-1; i++ {
+		b.WriteString(slugAdjectives[r.Bits(6)])
+		b.WriteByte('-')
+	}
+	b.WriteString(slugNouns[r.Bits(6)])
+	n := r.Uint32n(10000)
+	b.WriteByte('-')
+	b.WriteByte(byte('0' + n/1000))
+	b.WriteByte(byte('0' + n/100%10))
+	b.WriteByte(byte('0' + n/10%10))
+	b.WriteByte(byte('0' + n%10))
+	return b.String()
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var slugRe = regexp.MustCompile(`^([a-z]+-)+[0-9]{4}$`)
+
+func TestRand_Slug(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		words := rapid.IntRange(1, 8).Draw(t, "words").(int)
+		slug := rand.New(s).Slug(words)
+		if !slugRe.MatchString(slug) || strings.Count(slug, "-") != words {
+			t.Fatalf("got malformed slug %q for %v words", slug, words)
+		}
+		if again := rand.New(s).Slug(words); again != slug {
+			t.Fatalf("got %q and %q from the same seed", slug, again)
+		}
+	})
+}
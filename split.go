@@ -0,0 +1,143 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math"
+	"sort"
+)
+
+// KFold partitions the indices [0, n) into k folds of shuffled indices for cross-validation.
+// Fold sizes differ by at most one. It panics if k < 1 or k > n.
+//
+// When r is nil, KFold uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func KFold(r *Rand, n int, k int) [][]int {
+	if k < 1 || k > n {
+		panic("invalid argument to KFold")
+	}
+	p := randPerm(r, n)
+	folds := make([][]int, k)
+	for i := range folds {
+		folds[i] = p[i*n/k : (i+1)*n/k : (i+1)*n/k]
+	}
+	return folds
+}
+
+// StratifiedKFold is like [KFold] for len(labels) indices, but also keeps the proportion
+// of every label in each fold as close to the overall one as possible: the number of indices
+// with a given label differs by at most one between the folds. It panics if k < 1 or k > len(labels).
+//
+// When r is nil, StratifiedKFold uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func StratifiedKFold(r *Rand, labels []int, k int) [][]int {
+	if k < 1 || k > len(labels) {
+		panic("invalid argument to StratifiedKFold")
+	}
+	folds := make([][]int, k)
+	c := 0
+	for _, g := range strata(labels) {
+		randShuffleInts(r, g)
+		for _, ix := range g {
+			folds[c%k] = append(folds[c%k], ix)
+			c++
+		}
+	}
+	for _, f := range folds {
+		randShuffleInts(r, f)
+	}
+	return folds
+}
+
+// Split randomly splits the indices [0, n) into a training set with round(n*fraction) shuffled indices
+// and a test set with the rest. It panics if n < 0 or fraction is outside of [0, 1].
+//
+// When r is nil, Split uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func Split(r *Rand, n int, fraction float64) (train []int, test []int) {
+	if n < 0 || !(fraction >= 0 && fraction <= 1) {
+		panic("invalid argument to Split")
+	}
+	p := randPerm(r, n)
+	m := int(math.Round(float64(n) * fraction))
+	return p[:m:m], p[m:]
+}
+
+// StratifiedSplit is like [Split] for len(labels) indices, but also keeps the proportion
+// of every label in both sets as close to the overall one as possible: the training set contains
+// either the floor or the ceiling of count*fraction indices with a label that occurs count times.
+// It panics if fraction is outside of [0, 1].
+//
+// When r is nil, StratifiedSplit uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func StratifiedSplit(r *Rand, labels []int, fraction float64) (train []int, test []int) {
+	if !(fraction >= 0 && fraction <= 1) {
+		panic("invalid argument to StratifiedSplit")
+	}
+	groups := strata(labels)
+	// largest remainder method: round every quota down, then round up the ones
+	// with the largest fractional parts until the total is round(n*fraction)
+	counts := make([]int, len(groups))
+	order := make([]int, 0, len(groups))
+	rem := make([]float64, len(groups))
+	extra := int(math.Round(float64(len(labels)) * fraction))
+	for i, g := range groups {
+		q := float64(len(g)) * fraction
+		counts[i] = int(q)
+		extra -= counts[i]
+		if rem[i] = q - float64(counts[i]); rem[i] > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool { return rem[order[i]] > rem[order[j]] })
+	for _, i := range order[:extra] {
+		counts[i]++
+	}
+
+	train = make([]int, 0, len(labels))
+	test = make([]int, 0, len(labels))
+	for i, g := range groups {
+		randShuffleInts(r, g)
+		train = append(train, g[:counts[i]]...)
+		test = append(test, g[counts[i]:]...)
+	}
+	randShuffleInts(r, train)
+	randShuffleInts(r, test)
+	return train, test
+}
+
+// strata groups indices of labels by label, in order of the first occurrence of a label.
+func strata(labels []int) [][]int {
+	pos := map[int]int{}
+	var groups [][]int
+	for i, l := range labels {
+		j, ok := pos[l]
+		if !ok {
+			j = len(groups)
+			pos[l] = j
+			groups = append(groups, nil)
+		}
+		groups[j] = append(groups[j], i)
+	}
+	return groups
+}
+
+func randPerm(r *Rand, n int) []int {
+	if r == nil {
+		return Perm(n)
+	}
+	return r.Perm(n)
+}
+
+func randShuffleInts(r *Rand, p []int) {
+	swap := func(i, j int) { p[i], p[j] = p[j], p[i] }
+	if r == nil {
+		Shuffle(len(p), swap)
+	} else {
+		r.Shuffle(len(p), swap)
+	}
+}
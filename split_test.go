@@ -0,0 +1,122 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+// checkPartition checks that parts partition [0, n).
+func checkPartition(t *rapid.T, n int, parts ...[]int) {
+	seen := make([]bool, n)
+	for _, p := range parts {
+		for _, ix := range p {
+			if ix < 0 || ix >= n || seen[ix] {
+				t.Fatalf("index %v out of range or repeated in %v", ix, parts)
+			}
+			seen[ix] = true
+		}
+	}
+	for ix, ok := range seen {
+		if !ok {
+			t.Fatalf("index %v missing from %v", ix, parts)
+		}
+	}
+}
+
+func TestKFold(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, small).Draw(t, "n").(int)
+		k := rapid.IntRange(1, n).Draw(t, "k").(int)
+		folds := rand.KFold(rand.New(s), n, k)
+		if len(folds) != k {
+			t.Fatalf("got %v folds instead of %v", len(folds), k)
+		}
+		checkPartition(t, n, folds...)
+		for _, f := range folds {
+			if len(f) != n/k && len(f) != n/k+1 {
+				t.Fatalf("got fold of size %v for n=%v, k=%v", len(f), n, k)
+			}
+		}
+	})
+}
+
+func TestStratifiedKFold(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		labels := rapid.SliceOfN(rapid.IntRange(0, 3), 1, small).Draw(t, "labels").([]int)
+		k := rapid.IntRange(1, len(labels)).Draw(t, "k").(int)
+		folds := rand.StratifiedKFold(rand.New(s), labels, k)
+		checkPartition(t, len(labels), folds...)
+		for l := 0; l <= 3; l++ {
+			lo, hi := len(labels), 0
+			for _, f := range folds {
+				c := 0
+				for _, ix := range f {
+					if labels[ix] == l {
+						c++
+					}
+				}
+				if c < lo {
+					lo = c
+				}
+				if c > hi {
+					hi = c
+				}
+			}
+			if hi-lo > 1 {
+				t.Fatalf("label %v counts range from %v to %v between folds", l, lo, hi)
+			}
+		}
+	})
+}
+
+func TestSplit(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		f := rapid.Float64Range(0, 1).Draw(t, "f").(float64)
+		train, test := rand.Split(rand.New(s), n, f)
+		checkPartition(t, n, train, test)
+		if want := int(math.Round(float64(n) * f)); len(train) != want {
+			t.Fatalf("got %v training indices instead of %v", len(train), want)
+		}
+	})
+}
+
+func TestStratifiedSplit(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		labels := rapid.SliceOfN(rapid.IntRange(0, 3), 0, small).Draw(t, "labels").([]int)
+		f := rapid.Float64Range(0, 1).Draw(t, "f").(float64)
+		train, test := rand.StratifiedSplit(rand.New(s), labels, f)
+		checkPartition(t, len(labels), train, test)
+		if want := int(math.Round(float64(len(labels)) * f)); len(train) != want {
+			t.Fatalf("got %v training indices instead of %v", len(train), want)
+		}
+		for l := 0; l <= 3; l++ {
+			count, inTrain := 0, 0
+			for _, x := range labels {
+				if x == l {
+					count++
+				}
+			}
+			for _, ix := range train {
+				if labels[ix] == l {
+					inTrain++
+				}
+			}
+			if q := float64(count) * f; float64(inTrain) < math.Floor(q) || float64(inTrain) > math.Ceil(q) {
+				t.Fatalf("label %v: got %v of %v indices in the training set, fraction %v", l, inTrain, count, f)
+			}
+		}
+	})
+}
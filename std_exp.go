@@ -39,15 +39,24 @@ func (r *Rand) ExpFloat64() float64 {
 		if j < ke[i] {
 			return x
 		}
-		if i == 0 {
-			return re - math.Log(r.Float64())
-		}
-		if fe[i]+r.Float64()*(fe[i-1]-fe[i]) < math.Exp(-x) {
+		if x, ok := r.expSlow(i, x); ok {
 			return x
 		}
 	}
 }
 
+// expSlow handles the rare ExpFloat64 case of a sample outside of the ziggurat rectangles.
+// It returns false when the sample is rejected and a new one needs to be drawn.
+func (r *Rand) expSlow(i uint64, x float64) (float64, bool) {
+	if i == 0 {
+		return re - math.Log(r.Float64()), true
+	}
+	if fe[i]+r.Float64()*(fe[i-1]-fe[i]) < math.Exp(-x) {
+		return x, true
+	}
+	return 0, false
+}
+
 // ExpFloat64 returns an exponentially distributed float64 in the range
 // (0, +math.MaxFloat64] with an exponential distribution whose rate parameter
 // (lambda) is 1 and whose mean is 1/lambda (1).
@@ -47,25 +47,33 @@ func (r *Rand) NormFloat64() float64 {
 			// This case should be hit better than 99% of the time.
 			return x
 		}
+		if x, ok := r.normSlow(j, i, x); ok {
+			return x
+		}
+	}
+}
 
-		if i == 0 {
-			// This extra work is only required for the base strip.
-			for {
-				x = -math.Log(r.Float64()) * (1.0 / rn)
-				y := -math.Log(r.Float64())
-				if y+y >= x*x {
-					break
-				}
-			}
-			if j > 0 {
-				return rn + x
+// normSlow handles the rare NormFloat64 case of a sample outside of the ziggurat rectangles.
+// It returns false when the sample is rejected and a new one needs to be drawn.
+func (r *Rand) normSlow(j int64, i uint64, x float64) (float64, bool) {
+	if i == 0 {
+		// This extra work is only required for the base strip.
+		for {
+			x = -math.Log(r.Float64()) * (1.0 / rn)
+			y := -math.Log(r.Float64())
+			if y+y >= x*x {
+				break
 			}
-			return -rn - x
 		}
-		if fn[i]+r.Float64()*(fn[i-1]-fn[i]) < math.Exp(-.5*x*x) {
-			return x
+		if j > 0 {
+			return rn + x, true
 		}
+		return -rn - x, true
+	}
+	if fn[i]+r.Float64()*(fn[i-1]-fn[i]) < math.Exp(-.5*x*x) {
+		return x, true
 	}
+	return 0, false
 }
 
 // NormFloat64 returns a normally distributed float64 in
@@ -10,6 +10,7 @@ import (
 	"flag"
 	"fmt"
 	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
 	"io"
 	"math"
 	"os"
@@ -563,22 +564,19 @@ func TestUniformFactorial(t *testing.T) {
 						nsamples = 200
 					}
 					samples := make([]float64, nsamples)
+					const iters = 1000
+					want := make([]float64, nfact)
+					for i := range want {
+						want[i] = iters / float64(nfact)
+					}
 					for i := range samples {
 						// Generate some uniformly distributed values and count their occurrences.
-						const iters = 1000
 						counts := make([]int, nfact)
 						for i := 0; i < iters; i++ {
 							counts[test.fn()]++
 						}
 						// Calculate chi-squared and add to samples.
-						want := iters / float64(nfact)
-						var χ2 float64
-						for _, have := range counts {
-							err := float64(have) - want
-							χ2 += err * err
-						}
-						χ2 /= want
-						samples[i] = χ2
+						samples[i], _ = randtest.ChiSquared(counts, want)
 					}
 					
 					// Check that our samples approximate the appropriate normal distribution.
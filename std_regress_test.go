@@ -24,11 +24,116 @@ var (
 	skipregress = flag.Bool("skipregress", false, "skip the regression test")
 )
 
+// regressSkip lists the methods that are not covered by the golden outputs:
+// ones that do not produce pseudo-random values, and ones added after the
+// outputs were frozen (those are tested separately).
+var regressSkip = map[string]bool{
+	"Accept":             true,
+	"AddrFromPrefix":     true,
+	"AppendBinary":       true,
+	"Benford":            true,
+	"BenfordDigit":       true,
+	"BigFloat":           true,
+	"BigIntn":            true,
+	"Bit":                true,
+	"Bits":               true,
+	"Byte":               true,
+	"Close":              true,
+	"CorrelatedNormals":  true,
+	"CorrelatedUniforms": true,
+	"Derangement":        true,
+	"Derive":             true,
+	"DurationBetween":    true,
+	"ExpFloat64s":        true,
+	"Float32s":           true,
+	"Float64Full":        true,
+	"Float64s":           true,
+	"Float64Seq":         true,
+	"GBM":                true,
+	"Get":                true,
+	"HostFromPrefix":     true,
+	"InCircle":           true,
+	"InPolygon":          true,
+	"InRect":             true,
+	"InSphere":           true,
+	"Int16":              true,
+	"Int8":               true,
+	"IntnSeq":            true,
+	"IPv4":               true,
+	"IPv6":               true,
+	"Int63s":             true,
+	"Int64Range":         true,
+	"IntRange":           true,
+	"Jitter":             true,
+	"MAC":                true,
+	"Mask":               true,
+	"NormFloat64s":       true,
+	"OnSphere":           true,
+	"PermEach":           true,
+	"PoissonProcess":     true,
+	"PoissonProcessFunc": true,
+	"Prime":              true,
+	"Quaternion":         true,
+	"RandomWalk":         true,
+	"ReadParallel":       true,
+	"RotationMatrix":     true,
+	"Rune":               true,
+	"RuneString":         true,
+	"Seed":               true,
+	"SetPartition":       true,
+	"SetState":           true,
+	"ShuffleLines":       true,
+	"Sign":               true,
+	"SignFloat64":        true,
+	"Simplex":            true,
+	"Slug":               true,
+	"SpanningTree":       true,
+	"State":              true,
+	"StepBack":           true,
+	"Text":               true,
+	"TextN":              true,
+	"TimeBetween":        true,
+	"Tree":               true,
+	"TryInt31n":          true,
+	"TryInt63n":          true,
+	"TryIntn":            true,
+	"TryUint32n":         true,
+	"TryUint64n":         true,
+	"Uint128":            true,
+	"Uint16":             true,
+	"Uint32ns":           true,
+	"Uint32s":            true,
+	"Uint64ns":           true,
+	"Uint64s":            true,
+	"Uint64Seq":          true,
+	"UnitVector":         true,
+	"UTF8String":         true,
+	"UUIDv7":             true,
+	"UnmarshalBinary":    true,
+	"Wiener":             true,
+	"WriteRandom":        true,
+	"Zero":               true,
+	"Zeta":               true,
+}
+
+// TestRegress checks the outputs frozen by NewV1.
 func TestRegress(t *testing.T) {
 	if *skipregress {
 		t.Skip("-skipregress specified")
 	}
-	
+	checkRegress(t, rand.NewV1(0))
+}
+
+// TestRegressNew checks that the outputs of New have not changed. Unlike the ones of NewV1,
+// they may change in the future, but only together with an update of this test.
+func TestRegressNew(t *testing.T) {
+	if *skipregress || *printgolden {
+		t.Skip("-skipregress or -printgolden specified")
+	}
+	checkRegress(t, rand.New(0))
+}
+
+func checkRegress(t *testing.T, r *rand.Rand) {
 	var int32s = []int32{1, 10, 32, 1 << 20, 1<<20 + 1, 1000000000, 1 << 30, 1<<31 - 2, 1<<31 - 1}
 	var uint32s = []uint32{1, 10, 32, 1 << 20, 1<<20 + 1, 1000000000, 1 << 30, 1<<31 - 2, 1<<31 - 1, 1<<32 - 2, 1<<32 - 1}
 	var int64s = []int64{1, 10, 32, 1 << 20, 1<<20 + 1, 1000000000, 1 << 30, 1<<31 - 2, 1<<31 - 1, 1000000000000000000, 1 << 60, 1<<63 - 2, 1<<63 - 1}
@@ -36,7 +141,6 @@ func TestRegress(t *testing.T) {
 	var permSizes = []int{0, 1, 5, 8, 9, 10, 16}
 	var readBufferSizes = []int{0, 1, 7, 8, 9, 10}
 	var shuffleSliceSizes = []int{0, 1, 7, 8, 9, 10, 239}
-	r := rand.New(0)
 	
 	rv := reflect.ValueOf(r)
 	n := rv.NumMethod()
@@ -48,7 +152,7 @@ func TestRegress(t *testing.T) {
 		m := rv.Type().Method(i)
 		mv := rv.Method(i)
 		mt := mv.Type()
-		if m.Name == "Get" || m.Name == "Seed" || m.Name == "UnmarshalBinary" {
+		if regressSkip[m.Name] {
 			continue
 		}
 		for repeat := 0; repeat < 17; repeat++ {
@@ -0,0 +1,21 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// StepBack rewinds the generator by n 64-bit values, so that the next n calls of [Rand.Uint64]
+// return the n values most recently generated, in the same order. Values buffered from
+// a partially consumed 64-bit value (by [Rand.Uint32], [Rand.Read], [Rand.Bits] and the like) are discarded.
+// Rewinding takes O(n) time; it is a cheaper alternative to snapshotting the state at every step.
+func (r *Rand) StepBack(n uint64) {
+	for i := uint64(0); i < n; i++ {
+		r.prev64()
+	}
+	r.val = 0
+	r.pos = 0
+	r.bitVal = 0
+	r.bitPos = 0
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_StepBack(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		skip := rapid.IntRange(0, small).Draw(t, "skip").(int)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		for i := 0; i < skip; i++ {
+			r.Uint64()
+		}
+		state := r.State()
+		values := make([]uint64, n)
+		for i := range values {
+			values[i] = r.Uint64()
+		}
+		r.StepBack(uint64(n))
+		if got := r.State(); got != state {
+			t.Fatalf("got state %v instead of %v", got, state)
+		}
+		for i, v := range values {
+			if u := r.Uint64(); u != v {
+				t.Fatalf("got %v instead of %v at %v", u, v, i)
+			}
+		}
+	})
+}
+
+func TestRand_StepBackSeed(t *testing.T) {
+	// seeding runs the generator 12 times, starting with a = b = c = seed and w = 1
+	r := rand.New(1)
+	r.StepBack(12)
+	if got, want := r.State(), [rand.StateWords]uint64{1, 1, 1, 1}; got != want {
+		t.Fatalf("got state %v instead of %v", got, want)
+	}
+}
+
+func BenchmarkRand_StepBack(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		r.StepBack(1)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"hash/fnv"
+	"os"
+	"strconv"
+	"testing"
+)
+
+const (
+	testSeedFlagName = "rand.seed"
+	testSeedEnv      = "RAND_SEED"
+)
+
+// testSeedFlag is the value of -rand.seed flag, registered only in test binaries
+var testSeedFlag *string
+
+// NewTest returns a generator for use in the test tb. The seed is taken from the -rand.seed flag
+// (available in test binaries built with Go 1.21 or later), the RAND_SEED environment variable,
+// or chosen non-deterministically, in that order, and is logged with tb.Logf, so that
+// failing randomized tests can be reproduced. Generators for tests with different names
+// produce different sequences for the same seed.
+func NewTest(tb testing.TB) *Rand {
+	tb.Helper()
+	seed, src := rand64(), "random"
+	if testSeedFlag != nil && *testSeedFlag != "" {
+		seed, src = parseTestSeed(tb, *testSeedFlag, "-"+testSeedFlagName), "-"+testSeedFlagName
+	} else if env := os.Getenv(testSeedEnv); env != "" {
+		seed, src = parseTestSeed(tb, env, testSeedEnv), testSeedEnv
+	}
+	tb.Logf("rand: using %s seed %d (reproduce with -%s=%d or %s=%d)", src, seed, testSeedFlagName, seed, testSeedEnv, seed)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(tb.Name()))
+	return New(seed, h.Sum64())
+}
+
+func parseTestSeed(tb testing.TB, s string, src string) uint64 {
+	tb.Helper()
+	seed, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		tb.Fatalf("rand: invalid %s seed %q: %v", src, s, err)
+	}
+	return seed
+}
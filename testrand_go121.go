@@ -0,0 +1,21 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.21
+
+package rand
+
+import (
+	"flag"
+	"testing"
+)
+
+func init() {
+	// only test binaries get the flag, to keep the flag set of other programs clean
+	if testing.Testing() && flag.Lookup(testSeedFlagName) == nil {
+		testSeedFlag = flag.String(testSeedFlagName, "", "seed for generators returned by rand.NewTest")
+	}
+}
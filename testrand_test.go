@@ -0,0 +1,30 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"flag"
+	"github.com/gozelle/rand"
+	"testing"
+)
+
+func TestNewTest(t *testing.T) {
+	if flag.Lookup("rand.seed") != nil && flag.Lookup("rand.seed").Value.String() != "" {
+		t.Skip("-rand.seed is set")
+	}
+	t.Setenv("RAND_SEED", "42")
+	u1 := rand.NewTest(t).Uint64()
+	u2 := rand.NewTest(t).Uint64()
+	if u1 != u2 {
+		t.Fatalf("got different sequences for the same seed")
+	}
+	t.Run("sub", func(t *testing.T) {
+		if u3 := rand.NewTest(t).Uint64(); u3 == u1 {
+			t.Fatalf("got the same sequence for different tests")
+		}
+	})
+}
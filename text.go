@@ -0,0 +1,37 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+const (
+	base32Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	textLen        = 26 // at least 128 bits of randomness
+)
+
+// Text returns a pseudo-random string of 26 characters from the standard base32 alphabet,
+// in the same format as crypto/rand.Text.
+func (r *Rand) Text() string {
+	checkToken()
+	return r.textN(textLen)
+}
+
+// TextN returns a pseudo-random string of n characters from the standard base32 alphabet.
+// TextN panics if n < 0.
+func (r *Rand) TextN(n int) string {
+	if n < 0 {
+		panic("invalid argument to TextN")
+	}
+	checkToken()
+	return r.textN(n)
+}
+
+func (r *Rand) textN(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = base32Alphabet[r.Bits(5)]
+	}
+	return string(b)
+}
@@ -0,0 +1,41 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"encoding/base32"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"strings"
+	"testing"
+)
+
+func TestRand_Text(t *testing.T) {
+	r := rand.New(1)
+	s := r.Text()
+	if len(s) != 26 {
+		t.Fatalf("got %q of length %v instead of 26", s, len(s))
+	}
+	if _, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s[:24]); err != nil {
+		t.Fatalf("got %q that is not valid base32: %v", s, err)
+	}
+}
+
+func TestRand_TextN(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		text := r.TextN(n)
+		if len(text) != n {
+			t.Fatalf("got length %v instead of %v", len(text), n)
+		}
+		if strings.Trim(text, "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567") != "" {
+			t.Fatalf("got %q with characters outside of base32 alphabet", text)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"math"
+	"time"
+)
+
+// TimeBetween returns a uniformly distributed pseudo-random time in the half-open interval [lo, hi),
+// with nanosecond granularity. If lo and hi are equal, TimeBetween returns lo.
+// The result has the location of lo. TimeBetween panics if hi is before lo.
+func (r *Rand) TimeBetween(lo, hi time.Time) time.Time {
+	if hi.Before(lo) {
+		panic("invalid argument to TimeBetween")
+	}
+	if d := hi.Sub(lo); d < math.MaxInt64 {
+		return lo.Add(time.Duration(r.Uint64n(uint64(d))))
+	}
+	// the span does not fit into a time.Duration: choose the offset in seconds and nanoseconds,
+	// rejecting offsets past the end of the span
+	secs := hi.Unix() - lo.Unix()
+	nsecs := int64(hi.Nanosecond()) - int64(lo.Nanosecond())
+	if nsecs < 0 {
+		secs--
+		nsecs += int64(time.Second)
+	}
+	for {
+		s := int64(r.Uint64n(uint64(secs) + 1))
+		ns := int64(r.Uint32n(uint32(time.Second)))
+		if s < secs || ns < nsecs {
+			return time.Unix(lo.Unix()+s, int64(lo.Nanosecond())+ns).In(lo.Location())
+		}
+	}
+}
+
+// DurationBetween returns a uniformly distributed pseudo-random duration in the half-open interval [lo, hi).
+// If lo and hi are equal, DurationBetween returns lo. DurationBetween panics if hi < lo.
+func (r *Rand) DurationBetween(lo, hi time.Duration) time.Duration {
+	if hi < lo {
+		panic("invalid argument to DurationBetween")
+	}
+	return lo + time.Duration(r.Uint64n(uint64(hi)-uint64(lo)))
+}
+
+// Jitter returns d perturbed by up to ±fraction of its value: a uniformly distributed
+// pseudo-random duration in the closed interval [d - fraction*|d|, d + fraction*|d|],
+// clamped to the range of time.Duration. Jitter panics if fraction is not in [0, 1].
+func (r *Rand) Jitter(d time.Duration, fraction float64) time.Duration {
+	if !(fraction >= 0 && fraction <= 1) {
+		panic("invalid argument to Jitter")
+	}
+	abs := uint64(d)
+	if d < 0 {
+		abs = -abs
+	}
+	span := uint64(float64(abs) * fraction)
+	if span > abs {
+		span = abs // float64 rounding
+	}
+	lo, hi := d-time.Duration(span), d+time.Duration(span)
+	if d >= 0 && hi < d {
+		hi = math.MaxInt64
+	}
+	if d < 0 && lo > d {
+		lo = math.MinInt64
+	}
+	n := uint64(hi) - uint64(lo)
+	if n == math.MaxUint64 {
+		return time.Duration(r.next64())
+	}
+	return lo + time.Duration(r.Uint64n(n+1))
+}
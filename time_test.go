@@ -0,0 +1,77 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+	"time"
+)
+
+func TestRand_TimeBetween(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		loSec := rapid.Int64Range(-1<<40, 1<<40).Draw(t, "loSec").(int64)
+		hiSec := rapid.Int64Range(loSec, 1<<40).Draw(t, "hiSec").(int64)
+		loNsec := rapid.Int64Range(0, 999999999).Draw(t, "loNsec").(int64)
+		hiNsec := rapid.Int64Range(0, 999999999).Draw(t, "hiNsec").(int64)
+		lo := time.Unix(loSec, loNsec).UTC()
+		hi := time.Unix(hiSec, hiNsec).UTC()
+		if hi.Before(lo) {
+			lo, hi = hi, lo
+		}
+		r := rand.New(s)
+		v := r.TimeBetween(lo, hi)
+		if lo.Equal(hi) {
+			if !v.Equal(lo) {
+				t.Fatalf("got %v instead of %v", v, lo)
+			}
+			return
+		}
+		if v.Before(lo) || !v.Before(hi) {
+			t.Fatalf("got %v outside of [%v, %v)", v, lo, hi)
+		}
+		if v.Location() != lo.Location() {
+			t.Fatalf("got location %v instead of %v", v.Location(), lo.Location())
+		}
+	})
+}
+
+func TestRand_DurationBetween(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		lo := time.Duration(rapid.Int64().Draw(t, "lo").(int64))
+		hi := time.Duration(rapid.Int64Min(int64(lo)).Draw(t, "hi").(int64))
+		r := rand.New(s)
+		v := r.DurationBetween(lo, hi)
+		if lo == hi && v != lo {
+			t.Fatalf("got %v instead of %v", v, lo)
+		}
+		if lo != hi && (v < lo || v >= hi) {
+			t.Fatalf("got %v outside of [%v, %v)", v, lo, hi)
+		}
+	})
+}
+
+func TestRand_Jitter(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		d := time.Duration(rapid.Int64().Draw(t, "d").(int64))
+		fraction := rapid.Float64Range(0, 1).Draw(t, "fraction").(float64)
+		r := rand.New(s)
+		v := r.Jitter(d, fraction)
+		if (d > 0 && v < 0) || (d < 0 && v > 0) {
+			t.Fatalf("got %v with sign different from %v", v, d)
+		}
+		diff := math.Abs(float64(v) - float64(d))
+		if diff > math.Abs(float64(d))*fraction*(1+1e-9)+1 {
+			t.Fatalf("got %v more than %v away from %v", v, fraction, d)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// Tree returns, as a slice of n-1 edges, a labeled tree with vertices [0, n) uniformly
+// distributed over all n^(n-2) such trees. It panics if n < 0.
+func (r *Rand) Tree(n int) [][2]int {
+	if n < 0 {
+		panic("invalid argument to Tree")
+	}
+	if n < 2 {
+		return [][2]int{}
+	}
+	// every Prüfer sequence of length n-2 corresponds to exactly one tree
+	seq := make([]int, n-2)
+	degree := make([]int, n)
+	for i := range seq {
+		seq[i] = r.Intn(n)
+		degree[seq[i]]++
+	}
+	for i := range degree {
+		degree[i]++
+	}
+	edges := make([][2]int, 0, n-1)
+	ptr := 0
+	for degree[ptr] != 1 {
+		ptr++
+	}
+	leaf := ptr
+	for _, v := range seq {
+		edges = append(edges, [2]int{leaf, v})
+		degree[v]--
+		if degree[v] == 1 && v < ptr {
+			leaf = v
+		} else {
+			ptr++
+			for degree[ptr] != 1 {
+				ptr++
+			}
+			leaf = ptr
+		}
+	}
+	return append(edges, [2]int{leaf, n - 1})
+}
+
+// SpanningTree returns, as a slice of n-1 edges, a spanning tree of the graph with vertices [0, n)
+// and the given edges, uniformly distributed over all spanning trees of the graph. Parallel edges are
+// considered distinct. SpanningTree returns false if the graph is not connected.
+// It panics if n < 0 or any of the edges has an endpoint outside of [0, n).
+func (r *Rand) SpanningTree(n int, edges [][2]int) ([][2]int, bool) {
+	if n < 0 {
+		panic("invalid argument to SpanningTree")
+	}
+	adj := make([][]int, n)
+	for i, e := range edges {
+		if e[0] < 0 || e[0] >= n || e[1] < 0 || e[1] >= n {
+			panic("invalid argument to SpanningTree")
+		}
+		adj[e[0]] = append(adj[e[0]], i)
+		if e[1] != e[0] {
+			adj[e[1]] = append(adj[e[1]], i)
+		}
+	}
+	if !connected(n, edges, adj) {
+		return nil, false
+	}
+	tree := make([][2]int, 0, n)
+	if n < 2 {
+		return tree, true
+	}
+	// "Generating random spanning trees more quickly than the cover time" by David Bruce Wilson
+	inTree := make([]bool, n)
+	next := make([]int, n) // edge taken by the last visit of the loop-erased random walk
+	inTree[r.Intn(n)] = true
+	for i := 0; i < n; i++ {
+		for u := i; !inTree[u]; {
+			e := adj[u][r.Intn(len(adj[u]))]
+			next[u] = e
+			u = other(edges[e], u)
+		}
+		for u := i; !inTree[u]; {
+			inTree[u] = true
+			tree = append(tree, edges[next[u]])
+			u = other(edges[next[u]], u)
+		}
+	}
+	return tree, true
+}
+
+func other(e [2]int, u int) int {
+	if e[0] == u {
+		return e[1]
+	}
+	return e[0]
+}
+
+func connected(n int, edges [][2]int, adj [][]int) bool {
+	if n == 0 {
+		return true
+	}
+	seen := make([]bool, n)
+	seen[0] = true
+	stack := []int{0}
+	count := 1
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range adj[u] {
+			if v := other(edges[e], u); !seen[v] {
+				seen[v] = true
+				count++
+				stack = append(stack, v)
+			}
+		}
+	}
+	return count == n
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"fmt"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"sort"
+	"testing"
+)
+
+// isTree reports whether edges form a tree with vertices [0, n).
+func isTree(n int, edges [][2]int) bool {
+	if n > 0 && len(edges) != n-1 {
+		return false
+	}
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for _, e := range edges {
+		a, b := find(e[0]), find(e[1])
+		if a == b {
+			return false
+		}
+		parent[a] = b
+	}
+	return true
+}
+
+func treeKey(edges [][2]int) string {
+	keys := make([]string, len(edges))
+	for i, e := range edges {
+		if e[0] > e[1] {
+			e[0], e[1] = e[1], e[0]
+		}
+		keys[i] = fmt.Sprint(e)
+	}
+	sort.Strings(keys)
+	return fmt.Sprint(keys)
+}
+
+func TestRand_Tree(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		if edges := r.Tree(n); !isTree(n, edges) {
+			t.Fatalf("got %v which is not a tree", edges)
+		}
+	})
+}
+
+func TestRand_SpanningTree(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, tiny).Draw(t, "n").(int)
+		vertex := rapid.IntRange(0, n-1)
+		extra := rapid.SliceOf(rapid.ArrayOf(2, vertex)).Draw(t, "extra").([][2]int)
+		r := rand.New(s)
+		// random tree plus extra edges is connected
+		edges := append(r.Tree(n), extra...)
+		r.Shuffle(len(edges), func(i, j int) { edges[i], edges[j] = edges[j], edges[i] })
+		tree, ok := r.SpanningTree(n, edges)
+		if !ok {
+			t.Fatalf("connected graph reported as disconnected")
+		}
+		if !isTree(n, tree) {
+			t.Fatalf("got %v which is not a tree", tree)
+		}
+	})
+}
+
+func TestRand_SpanningTreeDisconnected(t *testing.T) {
+	r := rand.New(1)
+	if _, ok := r.SpanningTree(4, [][2]int{{0, 1}, {2, 3}}); ok {
+		t.Fatal("disconnected graph reported as connected")
+	}
+}
+
+func TestRand_TreeUniform(t *testing.T) {
+	r := rand.New(1)
+	checkUniformOutcomes(t, 125, func() string { return treeKey(r.Tree(5)) })
+}
+
+func TestRand_SpanningTreeUniform(t *testing.T) {
+	r := rand.New(1)
+	// complete graph K4 has 16 spanning trees
+	k4 := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}
+	checkUniformOutcomes(t, 16, func() string {
+		tree, _ := r.SpanningTree(4, k4)
+		return treeKey(tree)
+	})
+}
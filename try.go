@@ -0,0 +1,92 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "errors"
+
+// ErrInvalidBound is returned by the Try-prefixed functions and methods for non-positive bounds.
+var ErrInvalidBound = errors.New("rand: invalid bound")
+
+// TryInt31n is like [Rand.Int31n], but returns [ErrInvalidBound] instead of panicking if n <= 0.
+func (r *Rand) TryInt31n(n int32) (int32, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return r.Int31n(n), nil
+}
+
+// TryInt63n is like [Rand.Int63n], but returns [ErrInvalidBound] instead of panicking if n <= 0.
+func (r *Rand) TryInt63n(n int64) (int64, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return r.Int63n(n), nil
+}
+
+// TryIntn is like [Rand.Intn], but returns [ErrInvalidBound] instead of panicking if n <= 0.
+func (r *Rand) TryIntn(n int) (int, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return r.Intn(n), nil
+}
+
+// TryUint32n is like [Rand.Uint32n], but returns [ErrInvalidBound] instead of 0 if n == 0.
+func (r *Rand) TryUint32n(n uint32) (uint32, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+	return r.Uint32n(n), nil
+}
+
+// TryUint64n is like [Rand.Uint64n], but returns [ErrInvalidBound] instead of 0 if n == 0.
+func (r *Rand) TryUint64n(n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+	return r.Uint64n(n), nil
+}
+
+// TryInt31n is like [Int31n], but returns [ErrInvalidBound] instead of panicking if n <= 0.
+func TryInt31n(n int32) (int32, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return Int31n(n), nil
+}
+
+// TryInt63n is like [Int63n], but returns [ErrInvalidBound] instead of panicking if n <= 0.
+func TryInt63n(n int64) (int64, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return Int63n(n), nil
+}
+
+// TryIntn is like [Intn], but returns [ErrInvalidBound] instead of panicking if n <= 0.
+func TryIntn(n int) (int, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return Intn(n), nil
+}
+
+// TryUint32n is like [Uint32n], but returns [ErrInvalidBound] instead of 0 if n == 0.
+func TryUint32n(n uint32) (uint32, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+	return Uint32n(n), nil
+}
+
+// TryUint64n is like [Uint64n], but returns [ErrInvalidBound] instead of 0 if n == 0.
+func TryUint64n(n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+	return Uint64n(n), nil
+}
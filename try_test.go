@@ -0,0 +1,57 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"errors"
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_TryIntn(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(math.MinInt, math.MaxInt).Draw(t, "n").(int)
+		r1 := rand.New(s)
+		r2 := rand.New(s)
+		v, err := r1.TryIntn(n)
+		if n <= 0 {
+			if !errors.Is(err, rand.ErrInvalidBound) {
+				t.Fatalf("got %v, %v for bound %v", v, err, n)
+			}
+			return
+		}
+		if want := r2.Intn(n); err != nil || v != want {
+			t.Fatalf("got %v, %v instead of %v", v, err, want)
+		}
+	})
+}
+
+func TestRand_TryBounds(t *testing.T) {
+	r := rand.New(1)
+	checks := []error{
+		func() error { _, err := r.TryInt31n(-1); return err }(),
+		func() error { _, err := r.TryInt63n(0); return err }(),
+		func() error { _, err := r.TryUint32n(0); return err }(),
+		func() error { _, err := r.TryUint64n(0); return err }(),
+		func() error { _, err := rand.TryInt31n(0); return err }(),
+		func() error { _, err := rand.TryInt63n(-1); return err }(),
+		func() error { _, err := rand.TryIntn(0); return err }(),
+		func() error { _, err := rand.TryUint32n(0); return err }(),
+		func() error { _, err := rand.TryUint64n(0); return err }(),
+	}
+	for i, err := range checks {
+		if err != rand.ErrInvalidBound {
+			t.Errorf("check %v: got %v", i, err)
+		}
+	}
+	if v, err := rand.TryUint64n(10); err != nil || v >= 10 {
+		t.Errorf("got %v, %v", v, err)
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// UUIDv7 returns a version 7 UUID, as defined by RFC 9562, with the timestamp
+// taken from t (as milliseconds since Unix epoch, truncated to 48 bits)
+// and the remaining 74 bits filled with pseudo-random data.
+func (r *Rand) UUIDv7(t time.Time) (u [16]byte) {
+	ms := uint64(t.UnixMilli())
+	binary.BigEndian.PutUint64(u[0:], ms<<16|r.next64()&0x0fff|0x7000)                  // version 7
+	binary.BigEndian.PutUint64(u[8:], r.next64()&0x3fffffffffffffff|0x8000000000000000) // variant 10
+	return u
+}
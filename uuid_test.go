@@ -0,0 +1,48 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+	"time"
+)
+
+func TestRand_UUIDv7(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		ms := rapid.Int64Range(0, 1<<48-1).Draw(t, "ms").(int64)
+		r := rand.New(s)
+		u := r.UUIDv7(time.UnixMilli(ms))
+		if got := int64(binary.BigEndian.Uint64(u[:8]) >> 16); got != ms {
+			t.Fatalf("got timestamp %v instead of %v", got, ms)
+		}
+		if u[6]>>4 != 7 {
+			t.Fatalf("got version %v instead of 7", u[6]>>4)
+		}
+		if u[8]>>6 != 2 {
+			t.Fatalf("got variant %b instead of 10", u[8]>>6)
+		}
+	})
+}
+
+func TestRand_UUIDv7_Ordered(t *testing.T) {
+	r := rand.New(1)
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	prev := r.UUIDv7(ts)
+	for i := 0; i < small; i++ {
+		ts = ts.Add(time.Millisecond)
+		u := r.UUIDv7(ts)
+		if bytes.Compare(prev[:], u[:]) >= 0 {
+			t.Fatalf("got %x after %x for later timestamp", u, prev)
+		}
+		prev = u
+	}
+}
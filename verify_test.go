@@ -0,0 +1,37 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestVerifyStream(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(1, tiny).Draw(t, "n").(int)
+		golden := rand.GoldenStream(s, n)
+		if err := rand.VerifyStream(s, golden); err != nil {
+			t.Fatal(err)
+		}
+		i := rapid.IntRange(0, n-1).Draw(t, "i").(int)
+		golden[i]++
+		if err := rand.VerifyStream(s, golden); err == nil {
+			t.Fatalf("modified value at %v was not detected", i)
+		}
+	})
+}
+
+func TestVerifyStream_Fixed(t *testing.T) {
+	// frozen together with NewV1
+	golden := []uint64{0x3acfa029e3cc6041, 0xf5b6515bf2ee419c, 0x1259635894a29b61}
+	if err := rand.VerifyStream(0, golden); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+// SignStep is a [Rand.RandomWalk] step function returning -1 or +1 with equal probability.
+func SignStep(r *Rand) float64 {
+	return r.SignFloat64()
+}
+
+// NormalStep is a [Rand.RandomWalk] step function returning standard normally distributed values.
+func NormalStep(r *Rand) float64 {
+	return r.NormFloat64()
+}
+
+// RandomWalk returns, as a slice of n float64s, positions of a one-dimensional random walk
+// starting at 0 after each of n steps drawn by step. When step is nil, [NormalStep] is used,
+// with steps generated in bulk. RandomWalk panics if n < 0.
+func (r *Rand) RandomWalk(n int, step func(*Rand) float64) []float64 {
+	if n < 0 {
+		panic("invalid argument to RandomWalk")
+	}
+	walk := make([]float64, n)
+	if step == nil {
+		r.NormFloat64s(walk)
+	} else {
+		for i := range walk {
+			walk[i] = step(r)
+		}
+	}
+	var x float64
+	for i, s := range walk {
+		x += s
+		walk[i] = x
+	}
+	return walk
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_RandomWalk(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r := rand.New(s)
+		walk := r.RandomWalk(n, rand.SignStep)
+		if len(walk) != n {
+			t.Fatalf("got %v positions instead of %v", len(walk), n)
+		}
+		prev := 0.0
+		for _, x := range walk {
+			if math.Abs(x-prev) != 1 {
+				t.Fatalf("got step from %v to %v", prev, x)
+			}
+			prev = x
+		}
+	})
+}
+
+func TestRand_RandomWalkNil(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		n := rapid.IntRange(0, small).Draw(t, "n").(int)
+		r1 := rand.New(s)
+		r2 := rand.New(s)
+		w1 := r1.RandomWalk(n, nil)
+		w2 := r2.RandomWalk(n, rand.NormalStep)
+		for i := range w1 {
+			if w1[i] != w2[i] {
+				t.Fatalf("got %v instead of %v at step %v", w1[i], w2[i], i)
+			}
+		}
+	})
+}
+
+func BenchmarkRand_RandomWalk(b *testing.B) {
+	r := rand.New(1)
+	for i := 0; i < b.N; i++ {
+		sinkFloat64 = r.RandomWalk(small, nil)[small-1]
+	}
+}
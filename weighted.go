@@ -0,0 +1,110 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand
+
+// WeightedKey returns a key of weights chosen with probability proportional to its weight.
+// It panics if weights are empty, contain negative, NaN or infinite values, or sum to zero.
+//
+// Unlike Go's map iteration order, the key chosen depends only on weights and the state of r,
+// which makes it reproducible from a seed: WeightedKey sorts the keys before choosing.
+// For keys that are not ordered, use [WeightedChoice] with a slice of keys in a fixed order.
+//
+// When r is nil, WeightedKey uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func WeightedKey[K ordered](r *Rand, weights map[K]float64) K {
+	keys := sortedKeys(weights)
+	ws := make([]float64, len(keys))
+	for i, k := range keys {
+		ws[i] = weights[k]
+	}
+	i := weightedIndex(r, ws)
+	if i < 0 {
+		panic("invalid argument to WeightedKey")
+	}
+	return keys[i]
+}
+
+// A KeyChooser chooses keys with probabilities proportional to their weights in constant time.
+// KeyChooser is immutable and safe for concurrent use, as long as each goroutine uses its own [Rand].
+type KeyChooser[K ordered] struct {
+	keys  []K
+	table alias
+}
+
+// NewKeyChooser returns a KeyChooser for the keys of weights. Keys are sorted before building the table,
+// so that keys chosen for a given state of r are reproducible from a seed, unlike Go's map iteration order.
+// For keys that are not ordered, use [NewChooser] with a slice of keys in a fixed order.
+// It panics if weights are empty, contain negative, NaN or infinite values, or sum to zero.
+func NewKeyChooser[K ordered](weights map[K]float64) *KeyChooser[K] {
+	c := &KeyChooser[K]{keys: sortedKeys(weights)}
+	ws := make([]float64, len(c.keys))
+	for i, k := range c.keys {
+		ws[i] = weights[k]
+	}
+	var ok bool
+	c.table, ok = newAlias(ws)
+	if !ok {
+		panic("invalid argument to NewKeyChooser")
+	}
+	return c
+}
+
+// Key returns a key chosen with probability proportional to its weight.
+//
+// When r is nil, Key uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func (c *KeyChooser[K]) Key(r *Rand) K {
+	return c.keys[c.table.next(r)]
+}
+
+// WeightedChoice returns an element of items chosen with probability proportional to its weight.
+// It panics if len(weights) != len(items), or weights are empty, contain negative, NaN
+// or infinite values, or sum to zero. When choosing many times from the same items, use a [Chooser].
+//
+// When r is nil, WeightedChoice uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func WeightedChoice[T any](r *Rand, items []T, weights []float64) T {
+	if len(weights) != len(items) {
+		panic("invalid argument to WeightedChoice")
+	}
+	i := weightedIndex(r, weights)
+	if i < 0 {
+		panic("invalid argument to WeightedChoice")
+	}
+	return items[i]
+}
+
+// A Chooser chooses elements of a slice with probabilities proportional to their weights in constant time.
+// Chooser is immutable and safe for concurrent use, as long as each goroutine uses its own [Rand].
+type Chooser[T any] struct {
+	items []T
+	table alias
+}
+
+// NewChooser returns a Chooser for items with the given weights. NewChooser copies the items.
+// It panics if len(weights) != len(items), or weights are empty, contain negative, NaN
+// or infinite values, or sum to zero.
+func NewChooser[T any](items []T, weights []float64) *Chooser[T] {
+	if len(weights) != len(items) {
+		panic("invalid argument to NewChooser")
+	}
+	table, ok := newAlias(weights)
+	if !ok {
+		panic("invalid argument to NewChooser")
+	}
+	return &Chooser[T]{items: append([]T(nil), items...), table: table}
+}
+
+// Choose returns an element chosen with probability proportional to its weight.
+//
+// When r is nil, Choose uses non-deterministic goroutine-local
+// pseudo-random data source, and is safe for concurrent use from multiple goroutines.
+func (c *Chooser[T]) Choose(r *Rand) T {
+	return c.items[c.table.next(r)]
+}
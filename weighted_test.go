@@ -0,0 +1,100 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.18
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"testing"
+)
+
+func checkWeightedKeys(t *testing.T, weights map[string]float64, gen func() string) {
+	t.Helper()
+	const n = 10000
+	counts := map[string]int{}
+	for i := 0; i < n; i++ {
+		counts[gen()]++
+	}
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	var observed []int
+	var expected []float64
+	for k, w := range weights {
+		if w == 0 {
+			if counts[k] != 0 {
+				t.Fatalf("got %v draws of a zero-weight key %q", counts[k], k)
+			}
+			continue
+		}
+		observed = append(observed, counts[k])
+		expected = append(expected, n*w/sum)
+	}
+	if chi2, p := randtest.ChiSquared(observed, expected); p < 1e-4 {
+		t.Errorf("got χ² = %v, p = %v", chi2, p)
+	}
+}
+
+var testKeyWeights = map[string]float64{"a": 1, "b": 0, "c": 3, "d": 0.5, "e": 5.5}
+
+func TestWeightedKey(t *testing.T) {
+	r := rand.New(1)
+	checkWeightedKeys(t, testKeyWeights, func() string { return rand.WeightedKey(r, testKeyWeights) })
+	checkWeightedKeys(t, testKeyWeights, func() string { return rand.WeightedKey(nil, testKeyWeights) })
+}
+
+func TestKeyChooser(t *testing.T) {
+	r := rand.New(1)
+	c := rand.NewKeyChooser(testKeyWeights)
+	checkWeightedKeys(t, testKeyWeights, func() string { return c.Key(r) })
+	checkWeightedKeys(t, testKeyWeights, func() string { return c.Key(nil) })
+}
+
+func TestWeightedKey_Reproducible(t *testing.T) {
+	// map iteration order differs between iterations, so repeated runs catch dependence on it
+	for i := 0; i < 10; i++ {
+		r1, r2 := rand.New(1), rand.New(1)
+		c1, c2 := rand.NewKeyChooser(testKeyWeights), rand.NewKeyChooser(testKeyWeights)
+		for j := 0; j < 100; j++ {
+			if k1, k2 := rand.WeightedKey(r1, testKeyWeights), rand.WeightedKey(r2, testKeyWeights); k1 != k2 {
+				t.Fatalf("WeightedKey: got %q and %q for the same seed", k1, k2)
+			}
+			if k1, k2 := c1.Key(r1), c2.Key(r2); k1 != k2 {
+				t.Fatalf("KeyChooser: got %q and %q for the same seed", k1, k2)
+			}
+		}
+	}
+}
+
+var (
+	testItems       = []string{"a", "b", "c", "d", "e"}
+	testItemWeights = []float64{1, 0, 3, 0.5, 5.5}
+)
+
+func TestWeightedChoice(t *testing.T) {
+	r := rand.New(1)
+	checkWeightedKeys(t, testKeyWeights, func() string { return rand.WeightedChoice(r, testItems, testItemWeights) })
+	checkWeightedKeys(t, testKeyWeights, func() string { return rand.WeightedChoice(nil, testItems, testItemWeights) })
+}
+
+func TestChooser(t *testing.T) {
+	r := rand.New(1)
+	c := rand.NewChooser(testItems, testItemWeights)
+	checkWeightedKeys(t, testKeyWeights, func() string { return c.Choose(r) })
+	checkWeightedKeys(t, testKeyWeights, func() string { return c.Choose(nil) })
+}
+
+func BenchmarkKeyChooser(b *testing.B) {
+	r := rand.New(1)
+	c := rand.NewKeyChooser(testKeyWeights)
+	for i := 0; i < b.N; i++ {
+		_ = c.Key(r)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// Wiener returns, as a slice of len(times) float64s, a sample path of the Wiener process
+// (Brownian motion) X(t) = drift*t + volatility*W(t) starting at X(0) = 0, evaluated at times.
+// It panics if times are not non-negative, finite and non-decreasing.
+func (r *Rand) Wiener(times []float64, drift float64, volatility float64) []float64 {
+	path := make([]float64, len(times))
+	r.NormFloat64s(path)
+	var x, prev float64
+	for i, t := range times {
+		if !(t >= prev) || math.IsInf(t, 1) {
+			panic("invalid argument to Wiener")
+		}
+		dt := t - prev
+		x += drift*dt + volatility*math.Sqrt(dt)*path[i]
+		path[i] = x
+		prev = t
+	}
+	return path
+}
+
+// GBM returns, as a slice of len(times) float64s, a sample path of the geometric Brownian motion
+// S(t) = s0*exp((mu - sigma²/2)*t + sigma*W(t)) with drift mu and volatility sigma, evaluated at times.
+// It panics if times are not non-negative, finite and non-decreasing.
+func (r *Rand) GBM(times []float64, s0 float64, mu float64, sigma float64) []float64 {
+	path := r.Wiener(times, mu-sigma*sigma/2, sigma)
+	for i, x := range path {
+		path[i] = s0 * math.Exp(x)
+	}
+	return path
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"testing"
+)
+
+func TestRand_Wiener(t *testing.T) {
+	r := rand.New(1)
+	times := []float64{0, 0.5, 2, 2, 4}
+	const n = 10000
+	samples := make([]float64, n)
+	for i := range samples {
+		path := r.Wiener(times, 1, 2)
+		if path[0] != 0 || path[2] != path[3] {
+			t.Fatalf("got invalid path %v", path)
+		}
+		samples[i] = path[4] - path[1]
+	}
+	// X(4) - X(0.5) ~ N(1*3.5, 2²*3.5)
+	mean, stddev := 3.5, 2*math.Sqrt(3.5)
+	cdf := func(x float64) float64 { return 0.5 * math.Erfc(-(x-mean)/stddev/math.Sqrt2) }
+	if d, p := randtest.KolmogorovSmirnov(samples, cdf); p < 1e-4 {
+		t.Errorf("got D = %v, p = %v", d, p)
+	}
+}
+
+func TestRand_GBM(t *testing.T) {
+	r := rand.New(1)
+	const n = 10000
+	var sum float64
+	for i := 0; i < n; i++ {
+		path := r.GBM([]float64{1}, 100, 0.05, 0.2)
+		sum += path[0]
+	}
+	// E[S(t)] = s0*exp(mu*t)
+	if mean, want := sum/n, 100*math.Exp(0.05); math.Abs(mean-want) > 1 {
+		t.Errorf("got mean %v instead of %v", mean, want)
+	}
+}
+
+func BenchmarkRand_Wiener(b *testing.B) {
+	r := rand.New(1)
+	times := make([]float64, small)
+	for i := range times {
+		times[i] = float64(i) / small
+	}
+	for i := 0; i < b.N; i++ {
+		sinkFloat64 = r.Wiener(times, 0, 1)[small-1]
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand
+
+import "math"
+
+// Zeta returns, as a uint64, a pseudo-random number from the zeta distribution (unbounded Zipf's law)
+// with exponent s: the probability of k ≥ 1 is proportional to k^-s. Values that do not fit into uint64
+// are never returned; for s close to 1 this noticeably truncates the distribution, which is otherwise
+// heavy-tailed enough to exceed 2^64 often. Zeta panics if s <= 1.
+func (r *Rand) Zeta(s float64) uint64 {
+	if !(s > 1) {
+		panic("invalid argument to Zeta")
+	}
+	// Luc Devroye, "Non-Uniform Random Variate Generation", section X.6.1
+	a := s - 1
+	b := math.Exp2(a)
+	bm1 := math.Expm1(a * math.Ln2)
+	for {
+		u := 1 - r.Float64() // (0, 1]
+		v := r.Float64()
+		x := math.Floor(math.Pow(u, -1/a))
+		if x >= 1<<64 {
+			continue
+		}
+		t := math.Pow(1+1/x, a)
+		if v*x*(t-1)/bm1 <= t/b {
+			return uint64(x)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Gregory Petrosyan <gregory.petrosyan@gmail.com>
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package rand_test
+
+import (
+	"github.com/gozelle/rand"
+	"github.com/gozelle/rand/randtest"
+	"math"
+	"pgregory.net/rapid"
+	"testing"
+)
+
+func TestRand_Zeta(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.Uint64().Draw(t, "s").(uint64)
+		e := rapid.Float64Range(1.001, 100).Draw(t, "e").(float64)
+		r := rand.New(s)
+		for i := 0; i < 16; i++ {
+			if k := r.Zeta(e); k < 1 {
+				t.Fatalf("got %v for exponent %v", k, e)
+			}
+		}
+	})
+}
+
+func TestRand_ZetaDistribution(t *testing.T) {
+	const n, k = 100000, 8
+	r := rand.New(1)
+	observed := make([]int, k+1) // last bucket is the tail
+	for i := 0; i < n; i++ {
+		v := r.Zeta(2)
+		if v > k {
+			v = k + 1
+		}
+		observed[v-1]++
+	}
+	expected := make([]float64, k+1)
+	zeta2 := math.Pi * math.Pi / 6
+	tail := 1.0
+	for i := 0; i < k; i++ {
+		p := 1 / float64((i+1)*(i+1)) / zeta2
+		expected[i] = n * p
+		tail -= p
+	}
+	expected[k] = n * tail
+	if _, p := randtest.ChiSquared(observed, expected); p < 1e-4 {
+		t.Errorf("got p-value %v for %v", p, observed)
+	}
+}